@@ -5,35 +5,51 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"os/signal"
 	"path/filepath"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	chiMiddleware "github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
+	achievementHandler "keep-your-house-clean/internal/achievement"
 	"keep-your-house-clean/internal/auth"
 	complimentHandler "keep-your-house-clean/internal/compliment"
+	"keep-your-house-clean/internal/domain"
 	"keep-your-house-clean/internal/events"
 	eventHandlers "keep-your-house-clean/internal/events/handlers"
+	fairnessHandler "keep-your-house-clean/internal/fairness"
+	invitationHandler "keep-your-house-clean/internal/invitation"
+	notificationHandler "keep-your-house-clean/internal/notification"
 	"keep-your-house-clean/internal/platform/database"
+	"keep-your-house-clean/internal/platform/mail"
 	"keep-your-house-clean/internal/platform/migrations"
+	"keep-your-house-clean/internal/platform/notifier"
+	"keep-your-house-clean/internal/platform/scheduler"
 	authMiddleware "keep-your-house-clean/internal/platform/middleware"
+	reminderHandler "keep-your-house-clean/internal/reminder"
+	rewardHandler "keep-your-house-clean/internal/reward"
+	statsHandler "keep-your-house-clean/internal/stats"
+	"keep-your-house-clean/internal/stream"
 	taskHandler "keep-your-house-clean/internal/task"
 	tenantHandler "keep-your-house-clean/internal/tenant"
 	userHandler "keep-your-house-clean/internal/user"
 )
 
 func main() {
-	db, err := database.NewPostgresDB(database.Config{
+	dbConfig := database.Config{
 		Host:     getEnv("DB_HOST", "localhost"),
 		Port:     5432,
 		User:     getEnv("DB_USER", "postgres"),
 		Password: getEnv("DB_PASSWORD", "postgres"),
 		DBName:   getEnv("DB_NAME", "keep_your_house_clean"),
 		SSLMode:  getEnv("DB_SSLMODE", "disable"),
-	})
+	}
+
+	db, err := database.NewPostgresDB(dbConfig)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -50,37 +66,131 @@ func main() {
 	tenantHandlerInstance := tenantHandler.NewHandler(tenantService)
 
 	userRepo := database.NewUserRepository(db)
-	userService := userHandler.NewService(userRepo)
+	tokenRepo := database.NewTokenRepository(db)
+	pointsLedgerRepo := database.NewPointsLedgerRepository(db)
+	transactor := database.NewTransactor(db)
+	streakRepo := database.NewStreakRepository(db)
+	absenceRepo := database.NewAbsenceRepository(db)
+	taskRepo := database.NewTaskRepository(db)
+	userService := userHandler.NewService(userRepo, pointsLedgerRepo, streakRepo, absenceRepo, taskRepo, tokenRepo, transactor)
 	userHandlerInstance := userHandler.NewHandler(userService)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	dispatcher := events.NewDispatcher(ctx)
-	userPointsHandler := eventHandlers.NewUserPointsHandler(userRepo)
-	dispatcher.RegisterHandler(events.EventTypeTaskCompleted, userPointsHandler.Handle)
-	dispatcher.RegisterHandler(events.EventTypeTaskUndone, userPointsHandler.Handle)
-	dispatcher.RegisterHandler(events.EventTypeComplimentReceived, userPointsHandler.Handle)
-	dispatcher.Start()
+	outboxRepo := database.NewOutboxRepository(db)
+	publisher := events.NewOutboxPublisher(outboxRepo)
+	relay := events.NewRelay(outboxRepo, transactor, events.RelayConfig{
+		BatchSize:   100,
+		MaxAttempts: 10,
+		Lease:       time.Minute,
+		BaseBackoff: 5 * time.Second,
+		MaxBackoff:  time.Hour,
+	})
+	userPointsHandler := eventHandlers.NewUserPointsHandler(pointsLedgerRepo)
+	relay.RegisterHandler(events.EventTypeTaskCompleted, "user_points", userPointsHandler.Handle)
+	relay.RegisterHandler(events.EventTypeTaskUndone, "user_points", userPointsHandler.Handle)
+	relay.RegisterHandler(events.EventTypeComplimentReceived, "user_points", userPointsHandler.Handle)
+	streakHandler := eventHandlers.NewStreakHandler(streakRepo, transactor)
+	relay.RegisterHandler(events.EventTypeTaskCompleted, "streaks", streakHandler.Handle)
+	relay.RegisterHandler(events.EventTypeTaskUndone, "streaks", streakHandler.Handle)
+	notificationRepo := database.NewNotificationRepository(db)
+	notificationEventHandler := eventHandlers.NewNotificationHandler(notificationRepo, userRepo)
+	relay.RegisterHandler(events.EventTypeTaskCompleted, "notifications", notificationEventHandler.Handle)
+	relay.RegisterHandler(events.EventTypeComplimentReceived, "notifications", notificationEventHandler.Handle)
+	relay.RegisterHandler(events.EventTypeTaskAssigned, "notifications", notificationEventHandler.Handle)
+	relay.RegisterHandler(events.EventTypeTaskOverdue, "notifications", notificationEventHandler.Handle)
+	relay.RegisterHandler(events.EventTypeAchievementUnlocked, "notifications", notificationEventHandler.Handle)
+	streamBroker := stream.NewBroker(200, 64)
+	feed := events.NewFeed(outboxRepo)
+	feed.Subscribe(events.EventTypeTaskCompleted, streamBroker.Handle)
+	feed.Subscribe(events.EventTypeTaskUndone, streamBroker.Handle)
+	feed.Subscribe(events.EventTypeComplimentReceived, streamBroker.Handle)
+	feed.Subscribe(events.EventTypeAchievementUnlocked, streamBroker.Handle)
+
+	achievementDefinitionRepo := database.NewAchievementDefinitionRepository(db)
+	achievementRepo := database.NewAchievementRepository(db)
+	achievementEngine := achievementHandler.NewEngine(achievementDefinitionRepo, achievementRepo, transactor, publisher)
+	relay.RegisterHandler(events.EventTypeTaskCompleted, "achievements", achievementEngine.Handle)
+	relay.RegisterHandler(events.EventTypeTaskUndone, "achievements", achievementEngine.Handle)
+	relay.RegisterHandler(events.EventTypeComplimentReceived, "achievements", achievementEngine.Handle)
+	achievementService := achievementHandler.NewService(achievementDefinitionRepo, achievementRepo, userRepo)
+	achievementHandlerInstance := achievementHandler.NewHandler(achievementService)
 
-	taskRepo := database.NewTaskRepository(db)
-	taskService := taskHandler.NewService(taskRepo, dispatcher)
+	streamHandlerInstance := stream.NewHandler(streamBroker, getDurationEnv("STREAM_HEARTBEAT_INTERVAL", 15*time.Second))
+
+	taskRotationRepo := database.NewTaskRotationRepository(db)
+	taskSeriesRepo := database.NewTaskSeriesRepository(db)
+	checklistRepo := database.NewChecklistRepository(db)
+	taskService := taskHandler.NewService(taskRepo, userRepo, taskRotationRepo, taskSeriesRepo, streakRepo, absenceRepo, checklistRepo, publisher, transactor)
 	taskHandlerInstance := taskHandler.NewHandler(taskService)
 
+	mailer := newMailer()
+
+	reminderRepo := database.NewReminderRepository(db)
+	reminderService := reminderHandler.NewService(reminderRepo, taskRepo)
+	reminderHandlerInstance := reminderHandler.NewHandler(reminderService)
+	reminderJob := reminderHandler.NewJob(reminderRepo, map[domain.ReminderChannel]notifier.Notifier{
+		domain.ChannelInApp:   notifier.NewInAppNotifier(notificationRepo),
+		domain.ChannelEmail:   notifier.NewEmailNotifier(mailer),
+		domain.ChannelWebhook: notifier.NewWebhookNotifier(nil),
+	})
+
+	jobScheduler := scheduler.NewScheduler(ctx, database.NewAdvisoryLocker(db))
+	jobScheduler.Register(scheduler.Job{
+		Name:     "outbox_relay",
+		Interval: getDurationEnv("OUTBOX_RELAY_INTERVAL", time.Second),
+		Timeout:  time.Minute,
+		Run:      relay.Run,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "task_overdue",
+		Interval: getDurationEnv("OVERDUE_CHECK_INTERVAL", time.Minute),
+		Timeout:  30 * time.Second,
+		Run:      taskHandler.NewOverdueJob(taskRepo, publisher, transactor).Run,
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:     "task_reminders",
+		Interval: getDurationEnv("REMINDER_CHECK_INTERVAL", time.Minute),
+		Timeout:  time.Minute,
+		Run:      reminderJob.Run,
+	})
+
+	notificationService := notificationHandler.NewService(notificationRepo)
+	notificationHandlerInstance := notificationHandler.NewHandler(notificationService)
+
 	complimentRepo := database.NewComplimentRepository(db)
-	complimentService := complimentHandler.NewService(complimentRepo, userRepo, dispatcher)
+	complimentService := complimentHandler.NewService(complimentRepo, userRepo, publisher, transactor)
 	complimentHandlerInstance := complimentHandler.NewHandler(complimentService)
 
+	rewardRepo := database.NewRewardRepository(db)
+	rewardRedemptionRepo := database.NewRewardRedemptionRepository(db)
+	rewardService := rewardHandler.NewService(rewardRepo, rewardRedemptionRepo, pointsLedgerRepo, transactor)
+	rewardHandlerInstance := rewardHandler.NewHandler(rewardService)
+
+	statsRepo := database.NewStatsRepository(db)
+	statsService := statsHandler.NewService(statsRepo)
+	statsHandlerInstance := statsHandler.NewHandler(statsService)
+
+	fairnessService := fairnessHandler.NewService(taskRepo, userRepo, taskRotationRepo, statsRepo, absenceRepo)
+	fairnessHandlerInstance := fairnessHandler.NewHandler(fairnessService)
+
+	invitationRepo := database.NewInvitationRepository(db)
+	invitationService := invitationHandler.NewService(invitationRepo, userRepo)
+	invitationHandlerInstance := invitationHandler.NewHandler(invitationService)
+
 	jwtSecret := getEnv("JWT_SECRET", "your-secret-key")
-	authService := auth.NewService(userRepo, tenantRepo, jwtSecret)
-	authHandlerInstance := auth.NewHandler(authService)
+	authService := auth.NewService(userRepo, tenantRepo, tokenRepo, invitationRepo, transactor, jwtSecret)
+	passwordResetRepo := database.NewPasswordResetRepository(db)
+	passwordService := auth.NewPasswordService(authService, passwordResetRepo, mailer, getEnv("APP_BASE_URL", "http://localhost:5173"))
+	authHandlerInstance := auth.NewHandler(authService, passwordService)
 
 	r := chi.NewRouter()
 
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   []string{"https://*", "http://*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Last-Event-ID"},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: true,
 		MaxAge:           300,
@@ -94,11 +204,21 @@ func main() {
 	authHandlerInstance.RegisterRoutes(r)
 
 	r.Group(func(r chi.Router) {
-		r.Use(authMiddleware.JWTAuthMiddleware(jwtSecret))
+		r.Use(authMiddleware.JWTAuthMiddleware(jwtSecret, tokenRepo))
+		authHandlerInstance.RegisterProtectedRoutes(r)
 		tenantHandlerInstance.RegisterRoutes(r)
+		tenantHandlerInstance.RegisterAdminRoutes(r)
 		userHandlerInstance.RegisterRoutes(r)
 		taskHandlerInstance.RegisterRoutes(r)
 		complimentHandlerInstance.RegisterRoutes(r)
+		invitationHandlerInstance.RegisterRoutes(r)
+		reminderHandlerInstance.RegisterRoutes(r)
+		notificationHandlerInstance.RegisterRoutes(r)
+		rewardHandlerInstance.RegisterRoutes(r)
+		achievementHandlerInstance.RegisterRoutes(r)
+		statsHandlerInstance.RegisterRoutes(r)
+		fairnessHandlerInstance.RegisterRoutes(r)
+		streamHandlerInstance.RegisterRoutes(r)
 	})
 
 	r.NotFound(func(w http.ResponseWriter, req *http.Request) {
@@ -136,17 +256,58 @@ func main() {
 		}
 	}()
 
+	outboxListener := database.NewOutboxListener(database.DSN(dbConfig), 90*time.Second)
+	go func() {
+		if err := outboxListener.Listen(ctx, feed.Notify); err != nil {
+			log.Printf("Outbox listener stopped: %v", err)
+		}
+	}()
+
+	jobScheduler.Start()
+
 	log.Println("Server started successfully")
 	<-sigChan
 	log.Println("Shutting down server...")
 	cancel()
-	dispatcher.Stop()
+	jobScheduler.Stop()
 	log.Println("Server stopped")
 }
 
+func newMailer() mail.Mailer {
+	if getEnv("MAIL_DRIVER", "log") != "smtp" {
+		return mail.NewLogMailer(os.Getenv("MAIL_LOG_PATH"))
+	}
+
+	port, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+	if err != nil {
+		log.Fatalf("Invalid SMTP_PORT: %v", err)
+	}
+
+	return mail.NewSMTPMailer(mail.SMTPConfig{
+		Host:     getEnv("SMTP_HOST", "localhost"),
+		Port:     port,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     getEnv("MAIL_FROM", "no-reply@keepyourhouseclean.local"),
+	})
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
 	return defaultValue
 }
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("Invalid %s: %v", key, err)
+	}
+	return duration
+}
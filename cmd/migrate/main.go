@@ -2,7 +2,6 @@ package main
 
 import (
 	"database/sql"
-	"embed"
 	"fmt"
 	"log"
 	"os"
@@ -11,9 +10,6 @@ import (
 	"keep-your-house-clean/internal/platform/migrations"
 )
 
-//go:embed ../../migrations/*.sql
-var migrationFiles embed.FS
-
 func main() {
 	databaseURL := os.Getenv("DATABASE_URL")
 	if databaseURL == "" {
@@ -32,7 +28,7 @@ func main() {
 
 	fmt.Println("Connected to database successfully")
 
-	migrator := migrations.NewMigrator(db, migrationFiles)
+	migrator := migrations.NewMigrator(db, migrations.Files)
 	if err := migrator.Run(); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
@@ -240,6 +240,7 @@ func seedTasks(ctx context.Context, repo domain.TaskRepository, tenantID, userID
 			ScheduledById:  &userID,
 			FrequencyValue: t.FrequencyValue,
 			FrequencyUnit:  t.FrequencyUnit,
+			AnchorMode:     domain.AnchorCompletion,
 			Completed:      false,
 			CompletedById:  nil,
 			TenantID:       tenantID,
@@ -0,0 +1,35 @@
+package achievement
+
+import (
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type CreateAchievementRequest struct {
+	Code        string                   `json:"code"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Metric      domain.AchievementMetric `json:"metric"`
+	Threshold   int                      `json:"threshold"`
+}
+
+type UpdateAchievementRequest struct {
+	Code        *string                   `json:"code"`
+	Name        *string                   `json:"name"`
+	Description *string                   `json:"description"`
+	Metric      *domain.AchievementMetric `json:"metric"`
+	Threshold   *int                      `json:"threshold"`
+	Active      *bool                     `json:"active"`
+}
+
+type UserAchievementResponse struct {
+	ID          int64                    `json:"id"`
+	Code        string                   `json:"code"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Metric      domain.AchievementMetric `json:"metric"`
+	Threshold   int                      `json:"threshold"`
+	Progress    int                      `json:"progress"`
+	Unlocked    bool                     `json:"unlocked"`
+	AwardedAt   *time.Time               `json:"awarded_at"`
+}
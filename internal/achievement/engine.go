@@ -0,0 +1,144 @@
+package achievement
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
+	"time"
+)
+
+type progressUpdate struct {
+	metric domain.AchievementMetric
+	delta  int
+	streak bool
+}
+
+type Engine struct {
+	definitionRepo domain.AchievementDefinitionRepository
+	repo           domain.AchievementRepository
+	transactor     domain.Transactor
+	publisher      events.Publisher
+}
+
+func NewEngine(definitionRepo domain.AchievementDefinitionRepository, repo domain.AchievementRepository, transactor domain.Transactor, publisher events.Publisher) *Engine {
+	return &Engine{
+		definitionRepo: definitionRepo,
+		repo:           repo,
+		transactor:     transactor,
+		publisher:      publisher,
+	}
+}
+
+func (e *Engine) Handle(ctx context.Context, event events.Event) error {
+	switch payload := event.Payload.(type) {
+	case events.TaskCompletedPayload:
+		updates := []progressUpdate{
+			{metric: domain.MetricTasksCompleted, delta: 1},
+			{metric: domain.MetricCompletionStreak, streak: true},
+		}
+		if payload.WasOverdue {
+			updates = append(updates, progressUpdate{metric: domain.MetricOverdueCompleted, delta: 1})
+		}
+		return e.process(ctx, event, payload.TenantID, payload.CompletedBy, updates)
+	case events.TaskUndonePayload:
+		updates := []progressUpdate{{metric: domain.MetricTasksCompleted, delta: -1}}
+		if payload.WasOverdue {
+			updates = append(updates, progressUpdate{metric: domain.MetricOverdueCompleted, delta: -1})
+		}
+		return e.process(ctx, event, payload.TenantID, payload.CompletedBy, updates)
+	case events.ComplimentReceivedPayload:
+		if err := e.process(ctx, event, payload.TenantID, payload.FromUser, []progressUpdate{{metric: domain.MetricComplimentsSent, delta: 1}}); err != nil {
+			return err
+		}
+		return e.process(ctx, event, payload.TenantID, payload.ToUser, []progressUpdate{{metric: domain.MetricComplimentsReceived, delta: 1}})
+	}
+
+	return nil
+}
+
+func (e *Engine) process(ctx context.Context, event events.Event, tenantID int64, userID int64, updates []progressUpdate) error {
+	if userID == 0 || tenantID == 0 {
+		return nil
+	}
+
+	occurredAt := event.Timestamp
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	return e.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if event.ID != 0 {
+			fresh, err := e.repo.MarkEventProcessed(ctx, event.ID, userID)
+			if err != nil {
+				return err
+			}
+			if !fresh {
+				return nil
+			}
+		}
+
+		values := make(map[domain.AchievementMetric]int, len(updates))
+		for _, update := range updates {
+			var value int
+			var err error
+			if update.streak {
+				value, err = e.repo.RecordActivityDay(ctx, tenantID, userID, update.metric, occurredAt)
+			} else {
+				value, err = e.repo.IncrementProgress(ctx, tenantID, userID, update.metric, update.delta)
+			}
+			if err != nil {
+				return err
+			}
+			if update.delta >= 0 {
+				values[update.metric] = value
+			}
+		}
+
+		if len(values) == 0 {
+			return nil
+		}
+
+		definitions, err := e.definitionRepo.FetchAll(ctx, tenantID)
+		if err != nil {
+			return err
+		}
+
+		for i := range definitions {
+			definition := &definitions[i]
+			value, ok := values[definition.Metric]
+			if !ok || !definition.IsReachedBy(value) {
+				continue
+			}
+
+			awarded, err := e.repo.Award(ctx, &domain.UserAchievement{
+				TenantID:      tenantID,
+				UserID:        userID,
+				AchievementID: definition.ID,
+				AwardedAt:     occurredAt,
+			})
+			if err != nil {
+				return err
+			}
+			if !awarded {
+				continue
+			}
+
+			err = e.publisher.Publish(ctx, events.Event{
+				Type: events.EventTypeAchievementUnlocked,
+				Payload: events.AchievementUnlockedPayload{
+					AchievementID: definition.ID,
+					Code:          definition.Code,
+					Name:          definition.Name,
+					UserID:        userID,
+					TenantID:      tenantID,
+				},
+				Timestamp: occurredAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,155 @@
+package achievement
+
+import (
+	"context"
+	"keep-your-house-clean/internal/achievement/mocks"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
+	"testing"
+	"time"
+)
+
+func tenantPtr(id int64) *int64 {
+	return &id
+}
+
+func TestEngine_Handle(t *testing.T) {
+	now := time.Now()
+	definitions := []domain.AchievementDefinition{
+		{ID: 1, Code: "ten_tasks", Name: "Dez tarefas", Metric: domain.MetricTasksCompleted, Threshold: 10, Active: true},
+		{ID: 2, Code: "first_compliment", Name: "Primeiro elogio", Metric: domain.MetricComplimentsSent, Threshold: 1, Active: true},
+		{ID: 3, TenantID: tenantPtr(1), Code: "rescuer", Name: "Resgate", Metric: domain.MetricOverdueCompleted, Threshold: 1, Active: true},
+		{ID: 4, TenantID: tenantPtr(1), Code: "disabled", Name: "Desativada", Metric: domain.MetricTasksCompleted, Threshold: 1, Active: false},
+	}
+
+	tests := []struct {
+		name             string
+		events           []events.Event
+		initialProgress  map[domain.AchievementMetric]int
+		expectedAwards   []int64
+		expectedProgress map[domain.AchievementMetric]int
+	}{
+		{
+			name: "concede conquista ao atingir o limite de tarefas",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 5, CompletedBy: 7, TenantID: 1}, Timestamp: now},
+			},
+			initialProgress:  map[domain.AchievementMetric]int{domain.MetricTasksCompleted: 9},
+			expectedAwards:   []int64{1},
+			expectedProgress: map[domain.AchievementMetric]int{domain.MetricTasksCompleted: 10},
+		},
+		{
+			name: "não concede antes do limite nem regras inativas",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 5, CompletedBy: 7, TenantID: 1}, Timestamp: now},
+			},
+			expectedProgress: map[domain.AchievementMetric]int{domain.MetricTasksCompleted: 1},
+		},
+		{
+			name: "tarefa atrasada conta para a regra do tenant",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 5, CompletedBy: 7, TenantID: 1, WasOverdue: true}, Timestamp: now},
+			},
+			expectedAwards:   []int64{3},
+			expectedProgress: map[domain.AchievementMetric]int{domain.MetricTasksCompleted: 1, domain.MetricOverdueCompleted: 1},
+		},
+		{
+			name: "ignora reentrega do mesmo evento",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 5, CompletedBy: 7, TenantID: 1}, Timestamp: now},
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 5, CompletedBy: 7, TenantID: 1}, Timestamp: now},
+			},
+			expectedProgress: map[domain.AchievementMetric]int{domain.MetricTasksCompleted: 1},
+		},
+		{
+			name: "desfazer tarefa reduz o progresso sem conceder",
+			events: []events.Event{
+				{ID: 2, Type: events.EventTypeTaskUndone, Payload: events.TaskUndonePayload{TaskID: 5, CompletedBy: 7, TenantID: 1}, Timestamp: now},
+			},
+			initialProgress:  map[domain.AchievementMetric]int{domain.MetricTasksCompleted: 11},
+			expectedProgress: map[domain.AchievementMetric]int{domain.MetricTasksCompleted: 10},
+		},
+		{
+			name: "elogio conta para quem enviou",
+			events: []events.Event{
+				{ID: 3, Type: events.EventTypeComplimentReceived, Payload: events.ComplimentReceivedPayload{ComplimentID: 4, FromUser: 7, ToUser: 8, TenantID: 1}, Timestamp: now},
+			},
+			expectedAwards:   []int64{2},
+			expectedProgress: map[domain.AchievementMetric]int{domain.MetricComplimentsSent: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := make(map[domain.AchievementMetric]int)
+			for metric, value := range tt.initialProgress {
+				progress[metric] = value
+			}
+			processed := make(map[int64]bool)
+			awarded := make(map[int64]bool)
+			var awards []int64
+			var published []events.AchievementUnlockedPayload
+
+			repo := &mocks.MockAchievementRepository{
+				MarkEventProcessedFunc: func(ctx context.Context, eventID int64, userID int64) (bool, error) {
+					key := eventID*1000 + userID
+					if processed[key] {
+						return false, nil
+					}
+					processed[key] = true
+					return true, nil
+				},
+				IncrementProgressFunc: func(ctx context.Context, tenantID int64, userID int64, metric domain.AchievementMetric, delta int) (int, error) {
+					if userID != 7 {
+						return 0, nil
+					}
+					progress[metric] += delta
+					return progress[metric], nil
+				},
+				AwardFunc: func(ctx context.Context, award *domain.UserAchievement) (bool, error) {
+					if award.UserID != 7 || awarded[award.AchievementID] {
+						return false, nil
+					}
+					awarded[award.AchievementID] = true
+					awards = append(awards, award.AchievementID)
+					return true, nil
+				},
+			}
+			definitionRepo := &mocks.MockAchievementDefinitionRepository{
+				FetchAllFunc: func(ctx context.Context, tenantID int64) ([]domain.AchievementDefinition, error) {
+					return definitions, nil
+				},
+			}
+			publisher := &mocks.MockPublisher{
+				PublishFunc: func(ctx context.Context, event events.Event) error {
+					published = append(published, event.Payload.(events.AchievementUnlockedPayload))
+					return nil
+				},
+			}
+
+			engine := NewEngine(definitionRepo, repo, &mocks.MockTransactor{}, publisher)
+			for _, event := range tt.events {
+				if err := engine.Handle(context.Background(), event); err != nil {
+					t.Fatalf("erro inesperado: %v", err)
+				}
+			}
+
+			if len(awards) != len(tt.expectedAwards) {
+				t.Fatalf("esperadas %d conquistas, obtidas %v", len(tt.expectedAwards), awards)
+			}
+			for i, id := range tt.expectedAwards {
+				if awards[i] != id {
+					t.Errorf("conquista esperada %d, obtida %d", id, awards[i])
+				}
+			}
+			if len(published) != len(awards) {
+				t.Errorf("esperados %d eventos de conquista, obtidos %d", len(awards), len(published))
+			}
+			for metric, value := range tt.expectedProgress {
+				if progress[metric] != value {
+					t.Errorf("progresso de %s esperado %d, obtido %d", metric, value, progress[metric])
+				}
+			}
+		})
+	}
+}
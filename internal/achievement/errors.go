@@ -0,0 +1,11 @@
+package achievement
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrAchievementNotFound  = errors.New("achievement not found")
+	ErrInvalidAchievement   = errors.New("achievement must have a code, a name, a known metric and a positive threshold")
+	ErrGlobalAchievement    = errors.New("global achievements cannot be changed")
+)
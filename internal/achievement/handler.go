@@ -0,0 +1,140 @@
+package achievement
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/platform/middleware"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/api/v1/achievements", func(r chi.Router) {
+		r.Get("/", h.ListDefinitions)
+		r.With(middleware.RequirePermission(middleware.PermissionManageAchievements)).Post("/", h.CreateDefinition)
+		r.With(middleware.RequirePermission(middleware.PermissionManageAchievements)).Put("/{id}", h.UpdateDefinition)
+		r.With(middleware.RequirePermission(middleware.PermissionManageAchievements)).Delete("/{id}", h.DeleteDefinition)
+	})
+	r.Get("/api/v1/users/{id}/achievements", h.ListUserAchievements)
+}
+
+func (h *Handler) ListDefinitions(w http.ResponseWriter, r *http.Request) {
+	definitions, err := h.service.ListDefinitions(r.Context())
+	if err != nil {
+		respondWithAchievementError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, definitions)
+}
+
+func (h *Handler) CreateDefinition(w http.ResponseWriter, r *http.Request) {
+	var req CreateAchievementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	definition, err := h.service.CreateDefinition(r.Context(), req)
+	if err != nil {
+		respondWithAchievementError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, definition)
+}
+
+func (h *Handler) UpdateDefinition(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid achievement ID")
+		return
+	}
+
+	var req UpdateAchievementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	definition, err := h.service.UpdateDefinition(r.Context(), id, req)
+	if err != nil {
+		respondWithAchievementError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, definition)
+}
+
+func (h *Handler) DeleteDefinition(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid achievement ID")
+		return
+	}
+
+	if err := h.service.DeleteDefinition(r.Context(), id); err != nil {
+		respondWithAchievementError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ListUserAchievements(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	achievements, err := h.service.ListUserAchievements(r.Context(), id)
+	if err != nil {
+		respondWithAchievementError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, achievements)
+}
+
+func respondWithAchievementError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrUserNotAuthenticated) {
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+	if middleware.IsForbidden(err) || errors.Is(err, ErrGlobalAchievement) {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if errors.Is(err, ErrAchievementNotFound) || errors.Is(err, ErrUserNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if errors.Is(err, ErrInvalidAchievement) {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithError(w, http.StatusInternalServerError, err.Error())
+}
+
+func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(payload)
+}
+
+func respondWithError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,167 @@
+package mocks
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
+	"time"
+)
+
+type MockAchievementDefinitionRepository struct {
+	CreateFunc   func(ctx context.Context, definition *domain.AchievementDefinition) error
+	GetByIDFunc  func(ctx context.Context, id int64, tenantID int64) (*domain.AchievementDefinition, error)
+	FetchAllFunc func(ctx context.Context, tenantID int64) ([]domain.AchievementDefinition, error)
+	UpdateFunc   func(ctx context.Context, definition *domain.AchievementDefinition) error
+	DeleteFunc   func(ctx context.Context, id int64, tenantID int64) error
+}
+
+func (m *MockAchievementDefinitionRepository) Create(ctx context.Context, definition *domain.AchievementDefinition) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, definition)
+	}
+	return nil
+}
+
+func (m *MockAchievementDefinitionRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.AchievementDefinition, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockAchievementDefinitionRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.AchievementDefinition, error) {
+	if m.FetchAllFunc != nil {
+		return m.FetchAllFunc(ctx, tenantID)
+	}
+	return []domain.AchievementDefinition{}, nil
+}
+
+func (m *MockAchievementDefinitionRepository) Update(ctx context.Context, definition *domain.AchievementDefinition) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, definition)
+	}
+	return nil
+}
+
+func (m *MockAchievementDefinitionRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, tenantID)
+	}
+	return nil
+}
+
+type MockAchievementRepository struct {
+	MarkEventProcessedFunc func(ctx context.Context, eventID int64, userID int64) (bool, error)
+	IncrementProgressFunc  func(ctx context.Context, tenantID int64, userID int64, metric domain.AchievementMetric, delta int) (int, error)
+	RecordActivityDayFunc  func(ctx context.Context, tenantID int64, userID int64, metric domain.AchievementMetric, day time.Time) (int, error)
+	GetProgressFunc        func(ctx context.Context, userID int64, tenantID int64) (map[domain.AchievementMetric]int, error)
+	AwardFunc              func(ctx context.Context, award *domain.UserAchievement) (bool, error)
+	FetchAwardsByUserFunc  func(ctx context.Context, userID int64, tenantID int64) ([]domain.UserAchievement, error)
+}
+
+func (m *MockAchievementRepository) MarkEventProcessed(ctx context.Context, eventID int64, userID int64) (bool, error) {
+	if m.MarkEventProcessedFunc != nil {
+		return m.MarkEventProcessedFunc(ctx, eventID, userID)
+	}
+	return true, nil
+}
+
+func (m *MockAchievementRepository) IncrementProgress(ctx context.Context, tenantID int64, userID int64, metric domain.AchievementMetric, delta int) (int, error) {
+	if m.IncrementProgressFunc != nil {
+		return m.IncrementProgressFunc(ctx, tenantID, userID, metric, delta)
+	}
+	return delta, nil
+}
+
+func (m *MockAchievementRepository) RecordActivityDay(ctx context.Context, tenantID int64, userID int64, metric domain.AchievementMetric, day time.Time) (int, error) {
+	if m.RecordActivityDayFunc != nil {
+		return m.RecordActivityDayFunc(ctx, tenantID, userID, metric, day)
+	}
+	return 1, nil
+}
+
+func (m *MockAchievementRepository) GetProgress(ctx context.Context, userID int64, tenantID int64) (map[domain.AchievementMetric]int, error) {
+	if m.GetProgressFunc != nil {
+		return m.GetProgressFunc(ctx, userID, tenantID)
+	}
+	return map[domain.AchievementMetric]int{}, nil
+}
+
+func (m *MockAchievementRepository) Award(ctx context.Context, award *domain.UserAchievement) (bool, error) {
+	if m.AwardFunc != nil {
+		return m.AwardFunc(ctx, award)
+	}
+	return true, nil
+}
+
+func (m *MockAchievementRepository) FetchAwardsByUser(ctx context.Context, userID int64, tenantID int64) ([]domain.UserAchievement, error) {
+	if m.FetchAwardsByUserFunc != nil {
+		return m.FetchAwardsByUserFunc(ctx, userID, tenantID)
+	}
+	return []domain.UserAchievement{}, nil
+}
+
+type MockUserRepository struct {
+	GetByIDFunc func(ctx context.Context, id int64, tenantID int64) (*domain.User, error)
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *domain.User) error {
+	return nil
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return &domain.User{ID: id, TenantID: tenantID}, nil
+}
+
+func (m *MockUserRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	return m.GetByID(ctx, id, tenantID)
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByEmailAndTenant(ctx context.Context, email string, tenantID int64) (*domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.User, error) {
+	return []domain.User{}, nil
+}
+
+func (m *MockUserRepository) GetTopUsersByPoints(ctx context.Context, tenantID int64, limit int) ([]domain.User, error) {
+	return []domain.User{}, nil
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
+	return nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	return nil
+}
+
+type MockPublisher struct {
+	PublishFunc func(ctx context.Context, event events.Event) error
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, event events.Event) error {
+	if m.PublishFunc != nil {
+		return m.PublishFunc(ctx, event)
+	}
+	return nil
+}
+
+type MockTransactor struct {
+	WithinTransactionFunc func(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.WithinTransactionFunc != nil {
+		return m.WithinTransactionFunc(ctx, fn)
+	}
+	return fn(ctx)
+}
@@ -0,0 +1,241 @@
+package achievement
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"strings"
+	"time"
+)
+
+type Service struct {
+	definitionRepo domain.AchievementDefinitionRepository
+	repo           domain.AchievementRepository
+	userRepo       domain.UserRepository
+}
+
+func NewService(definitionRepo domain.AchievementDefinitionRepository, repo domain.AchievementRepository, userRepo domain.UserRepository) *Service {
+	return &Service{
+		definitionRepo: definitionRepo,
+		repo:           repo,
+		userRepo:       userRepo,
+	}
+}
+
+func (s *Service) ListDefinitions(ctx context.Context) ([]domain.AchievementDefinition, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	definitions, err := s.definitionRepo.FetchAll(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := []domain.AchievementDefinition{}
+	for _, definition := range definitions {
+		if definition.Active || middleware.IsAdmin(ctx) {
+			visible = append(visible, definition)
+		}
+	}
+
+	return visible, nil
+}
+
+func (s *Service) CreateDefinition(ctx context.Context, req CreateAchievementRequest) (*domain.AchievementDefinition, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageAchievements); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	definition := &domain.AchievementDefinition{
+		TenantID:    &tenantID,
+		Code:        strings.TrimSpace(req.Code),
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		Metric:      req.Metric,
+		Threshold:   req.Threshold,
+		Active:      true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := validateDefinition(definition); err != nil {
+		return nil, err
+	}
+
+	if err := s.definitionRepo.Create(ctx, definition); err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+func (s *Service) UpdateDefinition(ctx context.Context, id int64, req UpdateAchievementRequest) (*domain.AchievementDefinition, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageAchievements); err != nil {
+		return nil, err
+	}
+
+	definition, err := s.definitionRepo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if definition == nil {
+		return nil, ErrAchievementNotFound
+	}
+
+	if definition.IsGlobal() {
+		return nil, ErrGlobalAchievement
+	}
+
+	if req.Code != nil {
+		definition.Code = strings.TrimSpace(*req.Code)
+	}
+	if req.Name != nil {
+		definition.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		definition.Description = *req.Description
+	}
+	if req.Metric != nil {
+		definition.Metric = *req.Metric
+	}
+	if req.Threshold != nil {
+		definition.Threshold = *req.Threshold
+	}
+	if req.Active != nil {
+		definition.Active = *req.Active
+	}
+
+	if err := validateDefinition(definition); err != nil {
+		return nil, err
+	}
+
+	definition.UpdatedAt = time.Now()
+
+	if err := s.definitionRepo.Update(ctx, definition); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAchievementNotFound
+		}
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+func (s *Service) DeleteDefinition(ctx context.Context, id int64) error {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageAchievements); err != nil {
+		return err
+	}
+
+	definition, err := s.definitionRepo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if definition == nil {
+		return ErrAchievementNotFound
+	}
+
+	if definition.IsGlobal() {
+		return ErrGlobalAchievement
+	}
+
+	if err := s.definitionRepo.Delete(ctx, id, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrAchievementNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ListUserAchievements(ctx context.Context, userID int64) ([]UserAchievementResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	definitions, err := s.definitionRepo.FetchAll(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.repo.GetProgress(ctx, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	awards, err := s.repo.FetchAwardsByUser(ctx, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	awardedAt := make(map[int64]time.Time, len(awards))
+	for _, award := range awards {
+		awardedAt[award.AchievementID] = award.AwardedAt
+	}
+
+	achievements := []UserAchievementResponse{}
+	for _, definition := range definitions {
+		at, unlocked := awardedAt[definition.ID]
+		if !definition.Active && !unlocked {
+			continue
+		}
+
+		response := UserAchievementResponse{
+			ID:          definition.ID,
+			Code:        definition.Code,
+			Name:        definition.Name,
+			Description: definition.Description,
+			Metric:      definition.Metric,
+			Threshold:   definition.Threshold,
+			Progress:    progress[definition.Metric],
+			Unlocked:    unlocked,
+		}
+		if unlocked {
+			response.AwardedAt = &at
+		}
+
+		achievements = append(achievements, response)
+	}
+
+	return achievements, nil
+}
+
+func validateDefinition(definition *domain.AchievementDefinition) error {
+	if definition.Code == "" || definition.Name == "" || !definition.Metric.IsValid() || definition.Threshold <= 0 {
+		return ErrInvalidAchievement
+	}
+
+	return nil
+}
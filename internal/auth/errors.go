@@ -39,6 +39,34 @@ var (
 		Message:    "failed to hash password",
 		StatusCode: http.StatusInternalServerError,
 	}
+	ErrInvalidRefreshToken = &HTTPError{
+		Message:    "invalid refresh token",
+		StatusCode: http.StatusUnauthorized,
+	}
+	ErrInvalidInvitation = &HTTPError{
+		Message:    "invitation is invalid or expired",
+		StatusCode: http.StatusBadRequest,
+	}
+	ErrInvitationAlreadyUsed = &HTTPError{
+		Message:    "invitation has already been used",
+		StatusCode: http.StatusConflict,
+	}
+	ErrInvalidResetToken = &HTTPError{
+		Message:    "password reset token is invalid or expired",
+		StatusCode: http.StatusBadRequest,
+	}
+	ErrInvalidCurrentPassword = &HTTPError{
+		Message:    "current password is incorrect",
+		StatusCode: http.StatusBadRequest,
+	}
+	ErrPasswordTooShort = &HTTPError{
+		Message:    "password must have at least 8 characters",
+		StatusCode: http.StatusBadRequest,
+	}
+	ErrUserNotAuthenticated = &HTTPError{
+		Message:    "user not authenticated",
+		StatusCode: http.StatusUnauthorized,
+	}
 )
 
 func IsHTTPError(err error) (*HTTPError, bool) {
@@ -8,16 +8,29 @@ import (
 )
 
 type Handler struct {
-	service *Service
+	service         *Service
+	passwordService *PasswordService
 }
 
-func NewHandler(service *Service) *Handler {
-	return &Handler{service: service}
+func NewHandler(service *Service, passwordService *PasswordService) *Handler {
+	return &Handler{
+		service:         service,
+		passwordService: passwordService,
+	}
 }
 
 func (h *Handler) RegisterRoutes(r chi.Router) {
 	r.Post("/api/v1/auth/login", h.Login)
 	r.Post("/api/v1/auth/register", h.Register)
+	r.Post("/api/v1/auth/refresh", h.Refresh)
+	r.Post("/api/v1/auth/accept-invite", h.AcceptInvite)
+	r.Post("/api/v1/auth/forgot-password", h.ForgotPassword)
+	r.Post("/api/v1/auth/reset-password", h.ResetPassword)
+}
+
+func (h *Handler) RegisterProtectedRoutes(r chi.Router) {
+	r.Post("/api/v1/auth/logout", h.Logout)
+	r.Post("/api/v1/auth/change-password", h.ChangePassword)
 }
 
 func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
@@ -70,6 +83,146 @@ func (h *Handler) Register(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusCreated, response)
 }
 
+func (h *Handler) AcceptInvite(w http.ResponseWriter, r *http.Request) {
+	var req AcceptInviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Token == "" || req.Name == "" || req.Password == "" {
+		respondWithError(w, http.StatusBadRequest, "All fields are required")
+		return
+	}
+
+	response, err := h.service.AcceptInvite(r.Context(), req)
+	if err != nil {
+		if httpErr, ok := IsHTTPError(err); ok {
+			respondWithError(w, httpErr.HTTPCode(), httpErr.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, response)
+}
+
+func (h *Handler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var req RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.RefreshToken == "" {
+		respondWithError(w, http.StatusBadRequest, "Refresh token is required")
+		return
+	}
+
+	response, err := h.service.Refresh(r.Context(), req)
+	if err != nil {
+		if httpErr, ok := IsHTTPError(err); ok {
+			respondWithError(w, httpErr.HTTPCode(), httpErr.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	req := LogoutRequest{}
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	if err := h.service.Logout(r.Context(), req); err != nil {
+		if httpErr, ok := IsHTTPError(err); ok {
+			respondWithError(w, httpErr.HTTPCode(), httpErr.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.CurrentPassword == "" || req.NewPassword == "" {
+		respondWithError(w, http.StatusBadRequest, "Current and new password are required")
+		return
+	}
+
+	response, err := h.passwordService.ChangePassword(r.Context(), req)
+	if err != nil {
+		if httpErr, ok := IsHTTPError(err); ok {
+			respondWithError(w, httpErr.HTTPCode(), httpErr.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, response)
+}
+
+func (h *Handler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Email == "" {
+		respondWithError(w, http.StatusBadRequest, "Email is required")
+		return
+	}
+
+	if err := h.passwordService.ForgotPassword(r.Context(), req); err != nil {
+		respondWithError(w, http.StatusInternalServerError, "Failed to process password reset request")
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Token == "" || req.NewPassword == "" {
+		respondWithError(w, http.StatusBadRequest, "Token and new password are required")
+		return
+	}
+
+	if err := h.passwordService.ResetPassword(r.Context(), req); err != nil {
+		if httpErr, ok := IsHTTPError(err); ok {
+			respondWithError(w, httpErr.HTTPCode(), httpErr.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
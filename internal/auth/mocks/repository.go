@@ -0,0 +1,229 @@
+package mocks
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type MockUserRepository struct {
+	CreateFunc     func(ctx context.Context, user *domain.User) error
+	GetByIDFunc    func(ctx context.Context, id int64, tenantID int64) (*domain.User, error)
+	GetByEmailFunc func(ctx context.Context, email string) (*domain.User, error)
+	UpdateFunc     func(ctx context.Context, user *domain.User) error
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *domain.User) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, user)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	return m.GetByID(ctx, id, tenantID)
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if m.GetByEmailFunc != nil {
+		return m.GetByEmailFunc(ctx, email)
+	}
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByEmailAndTenant(ctx context.Context, email string, tenantID int64) (*domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetTopUsersByPoints(ctx context.Context, tenantID int64, limit int) ([]domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, user)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	return nil
+}
+
+type MockTenantRepository struct {
+	GetByIDFunc func(ctx context.Context, id int64) (*domain.Tenant, error)
+}
+
+func (m *MockTenantRepository) Create(ctx context.Context, tenant *domain.Tenant) error {
+	return nil
+}
+
+func (m *MockTenantRepository) GetByID(ctx context.Context, id int64) (*domain.Tenant, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id)
+	}
+	return &domain.Tenant{ID: id, Status: "active"}, nil
+}
+
+func (m *MockTenantRepository) GetByDomain(ctx context.Context, domain string) (*domain.Tenant, error) {
+	return nil, nil
+}
+
+func (m *MockTenantRepository) FetchAll(ctx context.Context) ([]domain.Tenant, error) {
+	return nil, nil
+}
+
+func (m *MockTenantRepository) Update(ctx context.Context, tenant *domain.Tenant) error {
+	return nil
+}
+
+func (m *MockTenantRepository) Delete(ctx context.Context, id int64) error {
+	return nil
+}
+
+type MockTokenRepository struct {
+	CreateRefreshTokenFunc    func(ctx context.Context, token *domain.RefreshToken) error
+	GetRefreshTokenByHashFunc func(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	RevokeRefreshTokenFunc    func(ctx context.Context, id int64, replacedByID *int64) error
+	RevokeAccessTokenFunc     func(ctx context.Context, jti string, userID int64, expiresAt time.Time) error
+	RevokeAllUserTokensFunc   func(ctx context.Context, userID int64) error
+	IsAccessTokenRevokedFunc  func(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error)
+}
+
+func (m *MockTokenRepository) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
+	if m.CreateRefreshTokenFunc != nil {
+		return m.CreateRefreshTokenFunc(ctx, token)
+	}
+	return nil
+}
+
+func (m *MockTokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	if m.GetRefreshTokenByHashFunc != nil {
+		return m.GetRefreshTokenByHashFunc(ctx, tokenHash)
+	}
+	return nil, nil
+}
+
+func (m *MockTokenRepository) RevokeRefreshToken(ctx context.Context, id int64, replacedByID *int64) error {
+	if m.RevokeRefreshTokenFunc != nil {
+		return m.RevokeRefreshTokenFunc(ctx, id, replacedByID)
+	}
+	return nil
+}
+
+func (m *MockTokenRepository) RevokeAccessToken(ctx context.Context, jti string, userID int64, expiresAt time.Time) error {
+	if m.RevokeAccessTokenFunc != nil {
+		return m.RevokeAccessTokenFunc(ctx, jti, userID, expiresAt)
+	}
+	return nil
+}
+
+func (m *MockTokenRepository) RevokeAllUserTokens(ctx context.Context, userID int64) error {
+	if m.RevokeAllUserTokensFunc != nil {
+		return m.RevokeAllUserTokensFunc(ctx, userID)
+	}
+	return nil
+}
+
+func (m *MockTokenRepository) IsAccessTokenRevoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error) {
+	if m.IsAccessTokenRevokedFunc != nil {
+		return m.IsAccessTokenRevokedFunc(ctx, jti, userID, issuedAt)
+	}
+	return false, nil
+}
+
+type MockInvitationRepository struct {
+	GetByTokenHashFunc func(ctx context.Context, tokenHash string) (*domain.Invitation, error)
+	ClaimFunc          func(ctx context.Context, id int64, acceptedAt time.Time) error
+	MarkAcceptedFunc   func(ctx context.Context, id int64, userID int64) error
+}
+
+func (m *MockInvitationRepository) Create(ctx context.Context, invitation *domain.Invitation) error {
+	return nil
+}
+
+func (m *MockInvitationRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Invitation, error) {
+	return nil, nil
+}
+
+func (m *MockInvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.Invitation, error) {
+	if m.GetByTokenHashFunc != nil {
+		return m.GetByTokenHashFunc(ctx, tokenHash)
+	}
+	return nil, nil
+}
+
+func (m *MockInvitationRepository) FetchPending(ctx context.Context, tenantID int64) ([]domain.Invitation, error) {
+	return nil, nil
+}
+
+func (m *MockInvitationRepository) Claim(ctx context.Context, id int64, acceptedAt time.Time) error {
+	if m.ClaimFunc != nil {
+		return m.ClaimFunc(ctx, id, acceptedAt)
+	}
+	return nil
+}
+
+func (m *MockInvitationRepository) MarkAccepted(ctx context.Context, id int64, userID int64) error {
+	if m.MarkAcceptedFunc != nil {
+		return m.MarkAcceptedFunc(ctx, id, userID)
+	}
+	return nil
+}
+
+func (m *MockInvitationRepository) Revoke(ctx context.Context, id int64, tenantID int64) error {
+	return nil
+}
+
+func (m *MockInvitationRepository) RevokePendingByEmail(ctx context.Context, email string, tenantID int64) error {
+	return nil
+}
+
+type MockPasswordResetRepository struct {
+	GetByTokenHashFunc func(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error)
+	MarkUsedFunc       func(ctx context.Context, id int64) error
+}
+
+func (m *MockPasswordResetRepository) Create(ctx context.Context, token *domain.PasswordResetToken) error {
+	return nil
+}
+
+func (m *MockPasswordResetRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	if m.GetByTokenHashFunc != nil {
+		return m.GetByTokenHashFunc(ctx, tokenHash)
+	}
+	return nil, nil
+}
+
+func (m *MockPasswordResetRepository) MarkUsed(ctx context.Context, id int64) error {
+	if m.MarkUsedFunc != nil {
+		return m.MarkUsedFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockPasswordResetRepository) InvalidateUserTokens(ctx context.Context, userID int64) error {
+	return nil
+}
+
+type MockTransactor struct {
+	WithinTransactionFunc func(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.WithinTransactionFunc != nil {
+		return m.WithinTransactionFunc(ctx, fn)
+	}
+	return fn(ctx)
+}
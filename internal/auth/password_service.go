@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/mail"
+	"keep-your-house-clean/internal/platform/middleware"
+	"keep-your-house-clean/internal/platform/security"
+	"log"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const passwordResetTTL = time.Hour
+
+type PasswordService struct {
+	auth       *Service
+	resetRepo  domain.PasswordResetRepository
+	mailer     mail.Mailer
+	appBaseURL string
+}
+
+func NewPasswordService(auth *Service, resetRepo domain.PasswordResetRepository, mailer mail.Mailer, appBaseURL string) *PasswordService {
+	return &PasswordService{
+		auth:       auth,
+		resetRepo:  resetRepo,
+		mailer:     mailer,
+		appBaseURL: appBaseURL,
+	}
+}
+
+func (s *PasswordService) ChangePassword(ctx context.Context, req ChangePasswordRequest) (*LoginResponse, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	user, err := s.auth.userRepo.GetByID(ctx, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		return nil, ErrInvalidCurrentPassword
+	}
+
+	if err := s.setPassword(ctx, user, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	response, _, err := s.auth.issueTokens(ctx, user)
+	return response, err
+}
+
+func (s *PasswordService) ForgotPassword(ctx context.Context, req ForgotPasswordRequest) error {
+	user, err := s.auth.userRepo.GetByEmail(ctx, domain.NormalizeEmail(req.Email))
+	if err != nil {
+		return err
+	}
+
+	if user == nil || user.Status != "active" {
+		return nil
+	}
+
+	if err := s.resetRepo.InvalidateUserTokens(ctx, user.ID); err != nil {
+		return err
+	}
+
+	token, err := security.GenerateToken()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	resetToken := &domain.PasswordResetToken{
+		UserID:    user.ID,
+		TenantID:  user.TenantID,
+		TokenHash: security.HashToken(token),
+		ExpiresAt: now.Add(passwordResetTTL),
+		CreatedAt: now,
+	}
+
+	if err := s.resetRepo.Create(ctx, resetToken); err != nil {
+		return err
+	}
+
+	message := mail.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nUse the link below to choose a new password. It expires in %d minutes.\n\n%s/reset-password?token=%s\n\nIf you did not request this, you can ignore this email.\n",
+			user.Name,
+			int(passwordResetTTL.Minutes()),
+			s.appBaseURL,
+			token,
+		),
+	}
+
+	if err := s.mailer.Send(ctx, message); err != nil {
+		log.Printf("Failed to send password reset email to user %d: %v", user.ID, err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *PasswordService) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	if len(req.NewPassword) < minPasswordLength {
+		return ErrPasswordTooShort
+	}
+
+	resetToken, err := s.resetRepo.GetByTokenHash(ctx, security.HashToken(req.Token))
+	if err != nil {
+		return err
+	}
+
+	if resetToken == nil || !resetToken.IsUsable(time.Now()) {
+		return ErrInvalidResetToken
+	}
+
+	user, err := s.auth.userRepo.GetByID(ctx, resetToken.UserID, resetToken.TenantID)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return ErrInvalidResetToken
+	}
+
+	return s.auth.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.resetRepo.MarkUsed(ctx, resetToken.ID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrInvalidResetToken
+			}
+			return err
+		}
+
+		return s.setPassword(ctx, user, req.NewPassword)
+	})
+}
+
+func (s *PasswordService) setPassword(ctx context.Context, user *domain.User, password string) error {
+	if len(password) < minPasswordLength {
+		return ErrPasswordTooShort
+	}
+
+	hashedPassword, err := HashPassword(password)
+	if err != nil {
+		return ErrPasswordHashFailed
+	}
+
+	user.Password = hashedPassword
+	user.UpdatedAt = time.Now()
+
+	if err := s.auth.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	return s.auth.tokenRepo.RevokeAllUserTokens(ctx, user.ID)
+}
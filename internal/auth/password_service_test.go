@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"keep-your-house-clean/internal/auth/mocks"
+	"keep-your-house-clean/internal/domain"
+	"testing"
+	"time"
+)
+
+func TestPasswordService_ResetPassword(t *testing.T) {
+	tests := []struct {
+		name            string
+		password        string
+		markUsedErr     error
+		expectedError   error
+		expectMarkUsed  bool
+		expectRevokeAll bool
+	}{
+		{
+			name:            "redefine a senha e revoga as sessões",
+			password:        "new-password",
+			expectMarkUsed:  true,
+			expectRevokeAll: true,
+		},
+		{
+			name:          "senha curta não consome o token",
+			password:      "short",
+			expectedError: ErrPasswordTooShort,
+		},
+		{
+			name:           "token já utilizado",
+			password:       "new-password",
+			markUsedErr:    sql.ErrNoRows,
+			expectedError:  ErrInvalidResetToken,
+			expectMarkUsed: true,
+		},
+		{
+			name:           "erro do banco ao consumir o token",
+			password:       "new-password",
+			markUsedErr:    errors.New("database error"),
+			expectedError:  errors.New("database error"),
+			expectMarkUsed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			markedUsed := false
+			revokedAll := false
+			transactions := 0
+
+			resetRepo := &mocks.MockPasswordResetRepository{
+				GetByTokenHashFunc: func(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+					return &domain.PasswordResetToken{ID: 3, UserID: 1, TenantID: 1, ExpiresAt: time.Now().Add(time.Hour)}, nil
+				},
+				MarkUsedFunc: func(ctx context.Context, id int64) error {
+					markedUsed = true
+					return tt.markUsedErr
+				},
+			}
+			tokenRepo := &mocks.MockTokenRepository{
+				RevokeAllUserTokensFunc: func(ctx context.Context, userID int64) error {
+					revokedAll = true
+					return nil
+				},
+			}
+			transactor := &mocks.MockTransactor{
+				WithinTransactionFunc: func(ctx context.Context, fn func(ctx context.Context) error) error {
+					transactions++
+					return fn(ctx)
+				},
+			}
+
+			authService := NewService(activeUserRepo(), &mocks.MockTenantRepository{}, tokenRepo, &mocks.MockInvitationRepository{}, transactor, testJWTSecret)
+			service := NewPasswordService(authService, resetRepo, nil, "")
+
+			err := service.ResetPassword(context.Background(), ResetPasswordRequest{Token: "reset", NewPassword: tt.password})
+
+			if markedUsed != tt.expectMarkUsed {
+				t.Errorf("consumo do token esperado %v, obtido %v", tt.expectMarkUsed, markedUsed)
+			}
+			if revokedAll != tt.expectRevokeAll {
+				t.Errorf("revogação de todas as sessões esperada %v, obtida %v", tt.expectRevokeAll, revokedAll)
+			}
+
+			if tt.expectedError != nil {
+				if err == nil || (!errors.Is(err, tt.expectedError) && err.Error() != tt.expectedError.Error()) {
+					t.Errorf("erro esperado '%v', obtido '%v'", tt.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if transactions != 1 {
+				t.Errorf("esperada 1 transação, obtidas %d", transactions)
+			}
+		})
+	}
+}
@@ -2,29 +2,45 @@ package auth
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"keep-your-house-clean/internal/platform/security"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	accessTokenTTL    = 15 * time.Minute
+	refreshTokenTTL   = 30 * 24 * time.Hour
+	minPasswordLength = 8
+)
+
 type Service struct {
-	userRepo   domain.UserRepository
-	tenantRepo domain.TenantRepository
-	jwtSecret  string
+	userRepo       domain.UserRepository
+	tenantRepo     domain.TenantRepository
+	tokenRepo      domain.TokenRepository
+	invitationRepo domain.InvitationRepository
+	transactor     domain.Transactor
+	jwtSecret      string
 }
 
-func NewService(userRepo domain.UserRepository, tenantRepo domain.TenantRepository, jwtSecret string) *Service {
+func NewService(userRepo domain.UserRepository, tenantRepo domain.TenantRepository, tokenRepo domain.TokenRepository, invitationRepo domain.InvitationRepository, transactor domain.Transactor, jwtSecret string) *Service {
 	return &Service{
-		userRepo:   userRepo,
-		tenantRepo: tenantRepo,
-		jwtSecret:  jwtSecret,
+		userRepo:       userRepo,
+		tenantRepo:     tenantRepo,
+		tokenRepo:      tokenRepo,
+		invitationRepo: invitationRepo,
+		transactor:     transactor,
+		jwtSecret:      jwtSecret,
 	}
 }
 
 func (s *Service) Login(ctx context.Context, req LoginRequest) (*LoginResponse, error) {
-	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	user, err := s.userRepo.GetByEmail(ctx, domain.NormalizeEmail(req.Email))
 	if err != nil {
 		return nil, err
 	}
@@ -48,26 +64,157 @@ func (s *Service) Login(ctx context.Context, req LoginRequest) (*LoginResponse,
 		return nil, err
 	}
 
-	token, err := s.generateToken(user.ID, user.TenantID)
+	response, _, err := s.issueTokens(ctx, user)
+	return response, err
+}
+
+func (s *Service) Refresh(ctx context.Context, req RefreshRequest) (*LoginResponse, error) {
+	current, err := s.tokenRepo.GetRefreshTokenByHash(ctx, security.HashToken(req.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil || current.IsExpired(time.Now()) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if current.IsRevoked() {
+		if err := s.tokenRepo.RevokeAllUserTokens(ctx, current.UserID); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepo.GetByID(ctx, current.UserID, current.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if user.Status != "active" {
+		return nil, ErrUserInactive
+	}
+
+	var response *LoginResponse
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var replacement *domain.RefreshToken
+		var err error
+		response, replacement, err = s.issueTokens(ctx, user)
+		if err != nil {
+			return err
+		}
+
+		return s.tokenRepo.RevokeRefreshToken(ctx, current.ID, &replacement.ID)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		if err := s.tokenRepo.RevokeAllUserTokens(ctx, current.UserID); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidRefreshToken
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	return response, nil
+}
+
+func (s *Service) Logout(ctx context.Context, req LogoutRequest) error {
+	userID := middleware.GetUserIDFromContext(ctx)
+	jti := middleware.GetTokenIDFromContext(ctx)
+	if userID == 0 || jti == "" {
+		return ErrUserNotAuthenticated
+	}
+
+	if req.AllDevices {
+		return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := s.tokenRepo.RevokeAccessToken(ctx, jti, userID, time.Now().Add(accessTokenTTL)); err != nil {
+				return err
+			}
+			return s.tokenRepo.RevokeAllUserTokens(ctx, userID)
+		})
+	}
+
+	if err := s.tokenRepo.RevokeAccessToken(ctx, jti, userID, time.Now().Add(accessTokenTTL)); err != nil {
+		return err
+	}
+
+	if req.RefreshToken == "" {
+		return nil
+	}
+
+	refreshToken, err := s.tokenRepo.GetRefreshTokenByHash(ctx, security.HashToken(req.RefreshToken))
+	if err != nil {
+		return err
+	}
+
+	if refreshToken == nil || refreshToken.UserID != userID {
+		return nil
+	}
+
+	err = s.tokenRepo.RevokeRefreshToken(ctx, refreshToken.ID, nil)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	return err
+}
+
+func (s *Service) issueTokens(ctx context.Context, user *domain.User) (*LoginResponse, *domain.RefreshToken, error) {
+	now := time.Now()
+	expiresAt := now.Add(accessTokenTTL)
+
+	accessToken, err := s.generateToken(user.ID, user.TenantID, user.Role, now, expiresAt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rawRefreshToken, err := security.GenerateToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken := &domain.RefreshToken{
+		UserID:    user.ID,
+		TenantID:  user.TenantID,
+		TokenHash: security.HashToken(rawRefreshToken),
+		ExpiresAt: now.Add(refreshTokenTTL),
+		CreatedAt: now,
+	}
+
+	if err := s.tokenRepo.CreateRefreshToken(ctx, refreshToken); err != nil {
+		return nil, nil, err
+	}
+
 	return &LoginResponse{
-		Token:    token,
-		UserID:   user.ID,
-		TenantID: user.TenantID,
-		Email:    user.Email,
-		Name:     user.Name,
-	}, nil
+		Token:        accessToken,
+		RefreshToken: rawRefreshToken,
+		ExpiresAt:    expiresAt,
+		UserID:       user.ID,
+		TenantID:     user.TenantID,
+		Email:        user.Email,
+		Name:         user.Name,
+		Role:         user.Role,
+	}, refreshToken, nil
 }
 
-func (s *Service) generateToken(userID, tenantID int64) (string, error) {
-	claims := jwt.MapClaims{
-		"user_id":   userID,
-		"tenant_id": tenantID,
-		"exp":       time.Now().Add(time.Hour * 24 * 7).Unix(),
-		"iat":       time.Now().Unix(),
+func (s *Service) generateToken(userID, tenantID int64, role string, issuedAt, expiresAt time.Time) (string, error) {
+	jti, err := security.GenerateToken()
+	if err != nil {
+		return "", err
+	}
+
+	claims := middleware.Claims{
+		UserID:   userID,
+		TenantID: tenantID,
+		Role:     role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -83,7 +230,8 @@ func (s *Service) Register(ctx context.Context, req RegisterRequest) (*LoginResp
 		return nil, ErrDomainExists
 	}
 
-	existingUser, err := s.userRepo.GetByEmail(ctx, req.Email)
+	email := domain.NormalizeEmail(req.Email)
+	existingUser, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil {
 		return nil, err
 	}
@@ -100,43 +248,114 @@ func (s *Service) Register(ctx context.Context, req RegisterRequest) (*LoginResp
 		UpdatedAt: now,
 	}
 
-	if err := s.tenantRepo.Create(ctx, tenant); err != nil {
+	hashedPassword, err := HashPassword(req.Password)
+	if err != nil {
+		return nil, ErrPasswordHashFailed
+	}
+
+	var response *LoginResponse
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.tenantRepo.Create(ctx, tenant); err != nil {
+			return err
+		}
+
+		user := &domain.User{
+			Name:       req.UserName,
+			Email:      email,
+			Password:   hashedPassword,
+			TenantID:   tenant.ID,
+			Points:     0,
+			Role:       domain.RoleAdmin,
+			Status:     "active",
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+
+		if err := s.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+
+		var err error
+		response, _, err = s.issueTokens(ctx, user)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (s *Service) AcceptInvite(ctx context.Context, req AcceptInviteRequest) (*LoginResponse, error) {
+	invitation, err := s.invitationRepo.GetByTokenHash(ctx, security.HashToken(req.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation == nil || !invitation.IsPending(time.Now()) {
+		return nil, ErrInvalidInvitation
+	}
+
+	tenant, err := s.tenantRepo.GetByID(ctx, invitation.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant == nil || tenant.Status != "active" {
+		return nil, ErrInvalidInvitation
+	}
+
+	existingUser, err := s.userRepo.GetByEmail(ctx, invitation.Email)
+	if err != nil {
 		return nil, err
 	}
+	if existingUser != nil {
+		return nil, ErrEmailExists
+	}
 
 	hashedPassword, err := HashPassword(req.Password)
 	if err != nil {
 		return nil, ErrPasswordHashFailed
 	}
 
+	now := time.Now()
 	user := &domain.User{
-		Name:       req.UserName,
-		Email:      req.Email,
-		Password:   hashedPassword,
-		TenantID:   tenant.ID,
-		Points:     0,
-		Role:       "admin",
-		Status:     "active",
-		CreatedAt:  now,
-		UpdatedAt:  now,
-	}
-
-	if err := s.userRepo.Create(ctx, user); err != nil {
-		return nil, err
+		Name:      req.Name,
+		Email:     invitation.Email,
+		Password:  hashedPassword,
+		TenantID:  invitation.TenantID,
+		Points:    0,
+		Role:      invitation.Role,
+		Status:    "active",
+		CreatedAt: now,
+		UpdatedAt: now,
 	}
 
-	token, err := s.generateToken(user.ID, tenant.ID)
+	var response *LoginResponse
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.invitationRepo.Claim(ctx, invitation.ID, now); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrInvitationAlreadyUsed
+			}
+			return err
+		}
+
+		if err := s.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+
+		if err := s.invitationRepo.MarkAccepted(ctx, invitation.ID, user.ID); err != nil {
+			return err
+		}
+
+		var err error
+		response, _, err = s.issueTokens(ctx, user)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &LoginResponse{
-		Token:    token,
-		UserID:   user.ID,
-		TenantID: tenant.ID,
-		Email:    user.Email,
-		Name:     user.Name,
-	}, nil
+	return response, nil
 }
 
 func HashPassword(password string) (string, error) {
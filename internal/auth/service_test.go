@@ -0,0 +1,348 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"keep-your-house-clean/internal/auth/mocks"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"keep-your-house-clean/internal/platform/security"
+	"testing"
+	"time"
+)
+
+const testJWTSecret = "test-secret"
+
+func newTestService(userRepo *mocks.MockUserRepository, tokenRepo *mocks.MockTokenRepository) *Service {
+	return NewService(userRepo, &mocks.MockTenantRepository{}, tokenRepo, &mocks.MockInvitationRepository{}, &mocks.MockTransactor{}, testJWTSecret)
+}
+
+func activeUserRepo() *mocks.MockUserRepository {
+	return &mocks.MockUserRepository{
+		GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+			return &domain.User{ID: id, TenantID: tenantID, Role: domain.RoleUser, Status: "active"}, nil
+		},
+	}
+}
+
+func TestService_Refresh(t *testing.T) {
+	const rawToken = "refresh-token"
+	revokedAt := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name              string
+		current           *domain.RefreshToken
+		userStatus        string
+		revokeErr         error
+		expectedError     error
+		expectRevokeAll   bool
+		expectReplacement bool
+	}{
+		{
+			name:              "rotaciona o refresh token",
+			current:           &domain.RefreshToken{ID: 10, UserID: 1, TenantID: 1, ExpiresAt: time.Now().Add(time.Hour)},
+			expectReplacement: true,
+		},
+		{
+			name:            "token reutilizado revoga todas as sessões",
+			current:         &domain.RefreshToken{ID: 10, UserID: 1, TenantID: 1, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt},
+			expectedError:   ErrInvalidRefreshToken,
+			expectRevokeAll: true,
+		},
+		{
+			name:            "token já reivindicado por outra requisição",
+			current:         &domain.RefreshToken{ID: 10, UserID: 1, TenantID: 1, ExpiresAt: time.Now().Add(time.Hour)},
+			revokeErr:       sql.ErrNoRows,
+			expectedError:   ErrInvalidRefreshToken,
+			expectRevokeAll: true,
+		},
+		{
+			name:          "token expirado",
+			current:       &domain.RefreshToken{ID: 10, UserID: 1, TenantID: 1, ExpiresAt: time.Now().Add(-time.Hour)},
+			expectedError: ErrInvalidRefreshToken,
+		},
+		{
+			name:          "token inexistente",
+			expectedError: ErrInvalidRefreshToken,
+		},
+		{
+			name:          "usuário inativo",
+			current:       &domain.RefreshToken{ID: 10, UserID: 1, TenantID: 1, ExpiresAt: time.Now().Add(time.Hour)},
+			userStatus:    "inactive",
+			expectedError: ErrUserInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *domain.RefreshToken
+			var revokedID int64
+			var replacedByID *int64
+			revokedAll := false
+
+			tokenRepo := &mocks.MockTokenRepository{
+				GetRefreshTokenByHashFunc: func(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+					if tokenHash != security.HashToken(rawToken) {
+						t.Errorf("hash inesperado: %s", tokenHash)
+					}
+					return tt.current, nil
+				},
+				CreateRefreshTokenFunc: func(ctx context.Context, token *domain.RefreshToken) error {
+					token.ID = 11
+					created = token
+					return nil
+				},
+				RevokeRefreshTokenFunc: func(ctx context.Context, id int64, replaced *int64) error {
+					revokedID = id
+					replacedByID = replaced
+					return tt.revokeErr
+				},
+				RevokeAllUserTokensFunc: func(ctx context.Context, userID int64) error {
+					revokedAll = true
+					return nil
+				},
+			}
+
+			userRepo := activeUserRepo()
+			if tt.userStatus != "" {
+				userRepo.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+					return &domain.User{ID: id, TenantID: tenantID, Status: tt.userStatus}, nil
+				}
+			}
+
+			response, err := newTestService(userRepo, tokenRepo).Refresh(context.Background(), RefreshRequest{RefreshToken: rawToken})
+
+			if revokedAll != tt.expectRevokeAll {
+				t.Errorf("revogação de todas as sessões esperada %v, obtida %v", tt.expectRevokeAll, revokedAll)
+			}
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("erro esperado '%v', obtido '%v'", tt.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if !tt.expectReplacement {
+				return
+			}
+			if created == nil || response.RefreshToken == "" || response.RefreshToken == rawToken {
+				t.Fatal("esperava um novo refresh token")
+			}
+			if created.TokenHash != security.HashToken(response.RefreshToken) {
+				t.Error("hash do novo refresh token não corresponde ao token retornado")
+			}
+			if revokedID != tt.current.ID {
+				t.Errorf("token revogado esperado %d, obtido %d", tt.current.ID, revokedID)
+			}
+			if replacedByID == nil || *replacedByID != created.ID {
+				t.Errorf("replaced_by_id esperado %d, obtido %v", created.ID, replacedByID)
+			}
+			if _, err := middleware.ParseToken(response.Token, testJWTSecret); err != nil {
+				t.Errorf("access token inválido: %v", err)
+			}
+		})
+	}
+}
+
+func TestService_Logout(t *testing.T) {
+	tests := []struct {
+		name                string
+		ctx                 context.Context
+		req                 LogoutRequest
+		refreshToken        *domain.RefreshToken
+		revokeRefreshErr    error
+		expectedError       error
+		expectRevokeAll     bool
+		expectAccessRevoked bool
+		expectRefreshRevoke bool
+	}{
+		{
+			name:                "logout de todos os dispositivos",
+			ctx:                 authenticatedContext(1, "jti-1"),
+			req:                 LogoutRequest{AllDevices: true},
+			expectRevokeAll:     true,
+			expectAccessRevoked: true,
+		},
+		{
+			name:                "logout revoga access e refresh token",
+			ctx:                 authenticatedContext(1, "jti-1"),
+			req:                 LogoutRequest{RefreshToken: "refresh-token"},
+			refreshToken:        &domain.RefreshToken{ID: 10, UserID: 1},
+			expectAccessRevoked: true,
+			expectRefreshRevoke: true,
+		},
+		{
+			name:                "refresh token já revogado é ignorado",
+			ctx:                 authenticatedContext(1, "jti-1"),
+			req:                 LogoutRequest{RefreshToken: "refresh-token"},
+			refreshToken:        &domain.RefreshToken{ID: 10, UserID: 1},
+			revokeRefreshErr:    sql.ErrNoRows,
+			expectAccessRevoked: true,
+			expectRefreshRevoke: true,
+		},
+		{
+			name:                "refresh token de outro usuário não é revogado",
+			ctx:                 authenticatedContext(1, "jti-1"),
+			req:                 LogoutRequest{RefreshToken: "refresh-token"},
+			refreshToken:        &domain.RefreshToken{ID: 10, UserID: 2},
+			expectAccessRevoked: true,
+		},
+		{
+			name:          "usuário não autenticado",
+			ctx:           context.Background(),
+			expectedError: ErrUserNotAuthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revokedAll := false
+			accessRevoked := ""
+			refreshRevoked := false
+
+			tokenRepo := &mocks.MockTokenRepository{
+				RevokeAllUserTokensFunc: func(ctx context.Context, userID int64) error {
+					revokedAll = true
+					return nil
+				},
+				RevokeAccessTokenFunc: func(ctx context.Context, jti string, userID int64, expiresAt time.Time) error {
+					accessRevoked = jti
+					return nil
+				},
+				GetRefreshTokenByHashFunc: func(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+					return tt.refreshToken, nil
+				},
+				RevokeRefreshTokenFunc: func(ctx context.Context, id int64, replacedByID *int64) error {
+					refreshRevoked = true
+					return tt.revokeRefreshErr
+				},
+			}
+
+			err := newTestService(activeUserRepo(), tokenRepo).Logout(tt.ctx, tt.req)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("erro esperado '%v', obtido '%v'", tt.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if revokedAll != tt.expectRevokeAll {
+				t.Errorf("revogação de todas as sessões esperada %v, obtida %v", tt.expectRevokeAll, revokedAll)
+			}
+			if tt.expectAccessRevoked && accessRevoked != "jti-1" {
+				t.Errorf("jti revogado esperado 'jti-1', obtido '%s'", accessRevoked)
+			}
+			if refreshRevoked != tt.expectRefreshRevoke {
+				t.Errorf("revogação do refresh token esperada %v, obtida %v", tt.expectRefreshRevoke, refreshRevoked)
+			}
+		})
+	}
+}
+
+func authenticatedContext(userID int64, jti string) context.Context {
+	ctx := middleware.SetUserIDInContext(context.Background(), userID)
+	ctx = middleware.SetTenantIDInContext(ctx, 1)
+	return middleware.SetTokenIDInContext(ctx, jti)
+}
+
+func TestService_AcceptInvite(t *testing.T) {
+	tests := []struct {
+		name          string
+		claimErr      error
+		expectedError error
+		expectCreate  bool
+	}{
+		{
+			name:         "aceita o convite",
+			expectCreate: true,
+		},
+		{
+			name:          "convite aceito por outra requisição",
+			claimErr:      sql.ErrNoRows,
+			expectedError: ErrInvitationAlreadyUsed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			var acceptedBy int64
+			userRepo := &mocks.MockUserRepository{
+				CreateFunc: func(ctx context.Context, user *domain.User) error {
+					created = true
+					user.ID = 9
+					return nil
+				},
+			}
+			invitationRepo := &mocks.MockInvitationRepository{
+				GetByTokenHashFunc: func(ctx context.Context, tokenHash string) (*domain.Invitation, error) {
+					return &domain.Invitation{ID: 5, TenantID: 1, Email: "ana@example.com", Role: domain.RoleUser, ExpiresAt: time.Now().Add(time.Hour)}, nil
+				},
+				ClaimFunc: func(ctx context.Context, id int64, acceptedAt time.Time) error {
+					return tt.claimErr
+				},
+				MarkAcceptedFunc: func(ctx context.Context, id int64, userID int64) error {
+					acceptedBy = userID
+					return nil
+				},
+			}
+
+			service := NewService(userRepo, &mocks.MockTenantRepository{}, &mocks.MockTokenRepository{}, invitationRepo, &mocks.MockTransactor{}, testJWTSecret)
+			response, err := service.AcceptInvite(context.Background(), AcceptInviteRequest{Token: "invite", Name: "Ana", Password: "password123"})
+
+			if created != tt.expectCreate {
+				t.Errorf("criação do usuário esperada %v, obtida %v", tt.expectCreate, created)
+			}
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("erro esperado '%v', obtido '%v'", tt.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if response.Email != "ana@example.com" {
+				t.Errorf("email esperado 'ana@example.com', obtido '%s'", response.Email)
+			}
+			if acceptedBy != 9 {
+				t.Errorf("convite aceito pelo usuário esperado 9, obtido %d", acceptedBy)
+			}
+		})
+	}
+}
+
+func TestService_Login_NormalizesEmail(t *testing.T) {
+	hashed, err := HashPassword("password123")
+	if err != nil {
+		t.Fatalf("erro ao gerar hash: %v", err)
+	}
+
+	var lookedUp string
+	userRepo := &mocks.MockUserRepository{
+		GetByEmailFunc: func(ctx context.Context, email string) (*domain.User, error) {
+			lookedUp = email
+			return &domain.User{ID: 1, TenantID: 1, Email: "ana@example.com", Password: hashed, Status: "active"}, nil
+		},
+	}
+
+	_, err = newTestService(userRepo, &mocks.MockTokenRepository{}).Login(context.Background(), LoginRequest{Email: " Ana@Example.com ", Password: "password123"})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if lookedUp != "ana@example.com" {
+		t.Errorf("email consultado esperado 'ana@example.com', obtido '%s'", lookedUp)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/platform/middleware"
 )
 
 type Handler struct {
@@ -168,6 +169,10 @@ func (h *Handler) DeleteCompliment(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteCompliment(r.Context(), id); err != nil {
+		if middleware.IsForbidden(err) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
 		if errors.Is(err, ErrComplimentNotFound) {
 			respondWithError(w, http.StatusNotFound, err.Error())
 			return
@@ -12,6 +12,8 @@ type MockComplimentRepository struct {
 	FetchAllFunc                  func(ctx context.Context, tenantID int64) ([]domain.Compliment, error)
 	GetLastReceivedByUserFunc     func(ctx context.Context, userID int64, tenantID int64) (*domain.ComplimentWithUser, error)
 	GetUserComplimentsHistoryFunc func(ctx context.Context, userID int64, tenantID int64) ([]domain.ComplimentWithUser, error)
+	GetUnviewedReceivedComplimentsFunc func(ctx context.Context, userID int64, tenantID int64) ([]domain.ComplimentWithUser, error)
+	MarkAsViewedFunc              func(ctx context.Context, ids []int64, userID int64, tenantID int64) error
 	DeleteFunc                    func(ctx context.Context, id int64, tenantID int64) error
 }
 
@@ -50,6 +52,20 @@ func (m *MockComplimentRepository) GetUserComplimentsHistory(ctx context.Context
 	return []domain.ComplimentWithUser{}, nil
 }
 
+func (m *MockComplimentRepository) GetUnviewedReceivedCompliments(ctx context.Context, userID int64, tenantID int64) ([]domain.ComplimentWithUser, error) {
+	if m.GetUnviewedReceivedComplimentsFunc != nil {
+		return m.GetUnviewedReceivedComplimentsFunc(ctx, userID, tenantID)
+	}
+	return []domain.ComplimentWithUser{}, nil
+}
+
+func (m *MockComplimentRepository) MarkAsViewed(ctx context.Context, ids []int64, userID int64, tenantID int64) error {
+	if m.MarkAsViewedFunc != nil {
+		return m.MarkAsViewedFunc(ctx, ids, userID, tenantID)
+	}
+	return nil
+}
+
 func (m *MockComplimentRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, id, tenantID)
@@ -58,21 +74,25 @@ func (m *MockComplimentRepository) Delete(ctx context.Context, id int64, tenantI
 }
 
 type MockUserRepository struct {
-	GetByIDFunc func(ctx context.Context, id int64) (*domain.User, error)
+	GetByIDFunc func(ctx context.Context, id int64, tenantID int64) (*domain.User, error)
 	UpdateFunc  func(ctx context.Context, user *domain.User) error
 	CreateFunc  func(ctx context.Context, user *domain.User) error
 	GetByEmailFunc func(ctx context.Context, email string) (*domain.User, error)
 	GetByEmailAndTenantFunc func(ctx context.Context, email string, tenantID int64) (*domain.User, error)
 	FetchAllFunc func(ctx context.Context, tenantID int64) ([]domain.User, error)
 	GetTopUsersByPointsFunc func(ctx context.Context, tenantID int64, limit int) ([]domain.User, error)
-	DeleteFunc func(ctx context.Context, id int64) error
+	DeleteFunc func(ctx context.Context, id int64, tenantID int64) error
 }
 
-func (m *MockUserRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
+func (m *MockUserRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
 	if m.GetByIDFunc != nil {
-		return m.GetByIDFunc(ctx, id)
+		return m.GetByIDFunc(ctx, id, tenantID)
 	}
-	return &domain.User{ID: id, Points: 0}, nil
+	return &domain.User{ID: id, TenantID: tenantID, Points: 0}, nil
+}
+
+func (m *MockUserRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	return m.GetByID(ctx, id, tenantID)
 }
 
 func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
@@ -117,18 +137,15 @@ func (m *MockUserRepository) GetTopUsersByPoints(ctx context.Context, tenantID i
 	return nil, nil
 }
 
-func (m *MockUserRepository) Delete(ctx context.Context, id int64) error {
+func (m *MockUserRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
 	if m.DeleteFunc != nil {
-		return m.DeleteFunc(ctx, id)
+		return m.DeleteFunc(ctx, id, tenantID)
 	}
 	return nil
 }
 
 type MockDispatcher struct {
-	DispatchFunc       func(event events.Event) error
-	RegisterHandlerFunc func(eventType events.EventType, handler events.EventHandler)
-	StartFunc          func()
-	StopFunc           func()
+	DispatchFunc func(event events.Event) error
 }
 
 func (m *MockDispatcher) Dispatch(event events.Event) error {
@@ -138,21 +155,18 @@ func (m *MockDispatcher) Dispatch(event events.Event) error {
 	return nil
 }
 
-func (m *MockDispatcher) RegisterHandler(eventType events.EventType, handler events.EventHandler) {
-	if m.RegisterHandlerFunc != nil {
-		m.RegisterHandlerFunc(eventType, handler)
-	}
+func (m *MockDispatcher) Publish(ctx context.Context, event events.Event) error {
+	return m.Dispatch(event)
 }
 
-func (m *MockDispatcher) Start() {
-	if m.StartFunc != nil {
-		m.StartFunc()
-	}
+
+type MockTransactor struct {
+	WithinTransactionFunc func(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
-func (m *MockDispatcher) Stop() {
-	if m.StopFunc != nil {
-		m.StopFunc()
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.WithinTransactionFunc != nil {
+		return m.WithinTransactionFunc(ctx, fn)
 	}
+	return fn(ctx)
 }
-
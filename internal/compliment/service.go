@@ -11,14 +11,16 @@ import (
 type Service struct {
 	repo          domain.ComplimentRepository
 	userRepo      domain.UserRepository
-	dispatcher    events.EventDispatcher
+	dispatcher    events.Publisher
+	transactor    domain.Transactor
 }
 
-func NewService(repo domain.ComplimentRepository, userRepo domain.UserRepository, dispatcher events.EventDispatcher) *Service {
+func NewService(repo domain.ComplimentRepository, userRepo domain.UserRepository, dispatcher events.Publisher, transactor domain.Transactor) *Service {
 	return &Service{
 		repo:       repo,
 		userRepo:   userRepo,
 		dispatcher: dispatcher,
+		transactor: transactor,
 	}
 }
 
@@ -37,7 +39,7 @@ func (s *Service) CreateCompliment(ctx context.Context, req CreateComplimentRequ
 		return nil, ErrInvalidUser
 	}
 
-	toUser, err := s.userRepo.GetByID(ctx, req.ToUserID)
+	toUser, err := s.userRepo.GetByID(ctx, req.ToUserID, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -58,22 +60,27 @@ func (s *Service) CreateCompliment(ctx context.Context, req CreateComplimentRequ
 		UpdatedAt:   now,
 	}
 
-	if err := s.repo.Create(ctx, compliment); err != nil {
-		return nil, err
-	}
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, compliment); err != nil {
+			return err
+		}
 
-	if req.Points > 0 {
 		event := events.Event{
 			Type: events.EventTypeComplimentReceived,
 			Payload: events.ComplimentReceivedPayload{
-				ToUser: req.ToUserID,
-				Points: req.Points,
+				ComplimentID: compliment.ID,
+				Title:        compliment.Title,
+				FromUser:     userID,
+				ToUser:       req.ToUserID,
+				TenantID:     tenantID,
+				Points:       req.Points,
 			},
 			Timestamp: now,
 		}
-		if err := s.dispatcher.Dispatch(event); err != nil {
-			return nil, err
-		}
+		return s.dispatcher.Publish(ctx, event)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return compliment, nil
@@ -167,11 +174,25 @@ func (s *Service) MarkComplimentsAsViewed(ctx context.Context, ids []int64) erro
 }
 
 func (s *Service) DeleteCompliment(ctx context.Context, id int64) error {
+	userID := middleware.GetUserIDFromContext(ctx)
 	tenantID := middleware.GetTenantIDFromContext(ctx)
-	if tenantID == 0 {
+	if userID == 0 || tenantID == 0 {
 		return ErrUserNotAuthenticated
 	}
 
+	if err := middleware.Authorize(ctx, middleware.PermissionManageCompliments); err != nil {
+		compliment, getErr := s.repo.GetByID(ctx, id, tenantID)
+		if getErr != nil {
+			return getErr
+		}
+		if compliment == nil {
+			return ErrComplimentNotFound
+		}
+		if compliment.FromUserID != userID {
+			return err
+		}
+	}
+
 	return s.repo.Delete(ctx, id, tenantID)
 }
 
@@ -18,7 +18,7 @@ func TestNewService(t *testing.T) {
 	repo := &mocks.MockComplimentRepository{}
 	userRepo := &mocks.MockUserRepository{}
 	dispatcher := &mocks.MockDispatcher{}
-	service := NewService(repo, userRepo, dispatcher)
+	service := NewService(repo, userRepo, dispatcher, &mocks.MockTransactor{})
 
 	if service == nil {
 		t.Fatal("NewService retornou nil")
@@ -56,7 +56,7 @@ func TestService_CreateCompliment(t *testing.T) {
 				ToUserID:    2,
 			},
 			mockSetup: func(cr *mocks.MockComplimentRepository, ur *mocks.MockUserRepository, d *mocks.MockDispatcher) {
-				ur.GetByIDFunc = func(ctx context.Context, id int64) (*domain.User, error) {
+				ur.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
 					return &domain.User{
 						ID:       2,
 						TenantID: 1,
@@ -142,7 +142,7 @@ func TestService_CreateCompliment(t *testing.T) {
 				ToUserID:    999,
 			},
 			mockSetup: func(cr *mocks.MockComplimentRepository, ur *mocks.MockUserRepository, d *mocks.MockDispatcher) {
-				ur.GetByIDFunc = func(ctx context.Context, id int64) (*domain.User, error) {
+				ur.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
 					return nil, nil
 				}
 			},
@@ -158,7 +158,7 @@ func TestService_CreateCompliment(t *testing.T) {
 				ToUserID:    2,
 			},
 			mockSetup: func(cr *mocks.MockComplimentRepository, ur *mocks.MockUserRepository, d *mocks.MockDispatcher) {
-				ur.GetByIDFunc = func(ctx context.Context, id int64) (*domain.User, error) {
+				ur.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
 					return &domain.User{
 						ID:       2,
 						TenantID: 999,
@@ -177,7 +177,7 @@ func TestService_CreateCompliment(t *testing.T) {
 				ToUserID:    2,
 			},
 			mockSetup: func(cr *mocks.MockComplimentRepository, ur *mocks.MockUserRepository, d *mocks.MockDispatcher) {
-				ur.GetByIDFunc = func(ctx context.Context, id int64) (*domain.User, error) {
+				ur.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
 					return &domain.User{
 						ID:       2,
 						TenantID: 1,
@@ -200,7 +200,7 @@ func TestService_CreateCompliment(t *testing.T) {
 				tt.mockSetup(mockRepo, mockUserRepo, mockDispatcher)
 			}
 
-			service := NewService(mockRepo, mockUserRepo, mockDispatcher)
+			service := NewService(mockRepo, mockUserRepo, mockDispatcher, &mocks.MockTransactor{})
 			ctx := tt.ctx
 			if userID := middleware.GetUserIDFromContext(ctx); userID > 0 {
 				ctx = middleware.SetTenantIDInContext(ctx, 1)
@@ -295,7 +295,7 @@ func TestService_GetComplimentByID(t *testing.T) {
 				tt.mockSetup(mockRepo)
 			}
 
-			service := NewService(mockRepo, mockUserRepo, mockDispatcher)
+			service := NewService(mockRepo, mockUserRepo, mockDispatcher, &mocks.MockTransactor{})
 			ctx := createContextWithUserID(1)
 			ctx = middleware.SetTenantIDInContext(ctx, 1)
 			compliment, err := service.GetComplimentByID(ctx, tt.id)
@@ -375,7 +375,7 @@ func TestService_ListCompliments(t *testing.T) {
 				tt.mockSetup(mockRepo)
 			}
 
-			service := NewService(mockRepo, mockUserRepo, mockDispatcher)
+			service := NewService(mockRepo, mockUserRepo, mockDispatcher, &mocks.MockTransactor{})
 			ctx := createContextWithUserID(1)
 			ctx = middleware.SetTenantIDInContext(ctx, 1)
 			compliments, err := service.ListCompliments(ctx)
@@ -407,21 +407,49 @@ func TestService_DeleteCompliment(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            int64
+		role          string
 		mockSetup     func(*mocks.MockComplimentRepository)
 		expectedError error
 	}{
 		{
 			name: "sucesso ao deletar elogio",
 			id:   1,
+			role: domain.RoleAdmin,
 			mockSetup: func(m *mocks.MockComplimentRepository) {
 				m.DeleteFunc = func(ctx context.Context, id int64, tenantID int64) error {
 					return nil
 				}
 			},
 		},
+		{
+			name: "sucesso ao deletar elogio enviado pelo próprio usuário",
+			id:   1,
+			role: domain.RoleUser,
+			mockSetup: func(m *mocks.MockComplimentRepository) {
+				m.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.Compliment, error) {
+					return &domain.Compliment{ID: 1, FromUserID: 1, ToUserID: 2}, nil
+				}
+			},
+		},
+		{
+			name: "erro ao deletar elogio de outro usuário sem permissão",
+			id:   1,
+			role: domain.RoleUser,
+			mockSetup: func(m *mocks.MockComplimentRepository) {
+				m.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.Compliment, error) {
+					return &domain.Compliment{ID: 1, FromUserID: 2, ToUserID: 3}, nil
+				}
+				m.DeleteFunc = func(ctx context.Context, id int64, tenantID int64) error {
+					t.Error("Delete não deveria ser chamado")
+					return nil
+				}
+			},
+			expectedError: &middleware.ForbiddenError{Role: domain.RoleUser, Permission: middleware.PermissionManageCompliments},
+		},
 		{
 			name: "erro do repositório",
 			id:   1,
+			role: domain.RoleAdmin,
 			mockSetup: func(m *mocks.MockComplimentRepository) {
 				m.DeleteFunc = func(ctx context.Context, id int64, tenantID int64) error {
 					return errors.New("database error")
@@ -440,9 +468,10 @@ func TestService_DeleteCompliment(t *testing.T) {
 				tt.mockSetup(mockRepo)
 			}
 
-			service := NewService(mockRepo, mockUserRepo, mockDispatcher)
+			service := NewService(mockRepo, mockUserRepo, mockDispatcher, &mocks.MockTransactor{})
 			ctx := createContextWithUserID(1)
 			ctx = middleware.SetTenantIDInContext(ctx, 1)
+			ctx = middleware.SetRoleInContext(ctx, tt.role)
 			err := service.DeleteCompliment(ctx, tt.id)
 
 			if tt.expectedError != nil {
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Absence struct {
+	ID          int64      `json:"id"`
+	TenantID    int64      `json:"tenant_id"`
+	UserID      int64      `json:"user_id"`
+	StartsOn    time.Time  `json:"starts_on"`
+	EndsOn      *time.Time `json:"ends_on"`
+	Reason      string     `json:"reason"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CreatedById *int64     `json:"created_by_id"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func CalendarDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (a *Absence) IsVacation() bool {
+	return a.EndsOn == nil
+}
+
+func (a *Absence) Covers(t time.Time) bool {
+	day := CalendarDay(t)
+	if day.Before(CalendarDay(a.StartsOn)) {
+		return false
+	}
+	return a.EndsOn == nil || !day.After(CalendarDay(*a.EndsOn))
+}
+
+func (a *Absence) Overlaps(other *Absence) bool {
+	if a.EndsOn != nil && CalendarDay(*a.EndsOn).Before(CalendarDay(other.StartsOn)) {
+		return false
+	}
+	if other.EndsOn != nil && CalendarDay(*other.EndsOn).Before(CalendarDay(a.StartsOn)) {
+		return false
+	}
+	return true
+}
+
+func (a *Absence) OverlapDays(from time.Time, to time.Time) int {
+	start := CalendarDay(a.StartsOn)
+	if windowStart := CalendarDay(from); windowStart.After(start) {
+		start = windowStart
+	}
+
+	end := CalendarDay(to)
+	if a.EndsOn != nil {
+		if absenceEnd := CalendarDay(*a.EndsOn).AddDate(0, 0, 1); absenceEnd.Before(end) {
+			end = absenceEnd
+		}
+	}
+
+	if !end.After(start) {
+		return 0
+	}
+	return int(end.Sub(start).Hours() / 24)
+}
+
+func IsAbsent(absences []Absence, userID int64, at time.Time) bool {
+	for i := range absences {
+		if absences[i].UserID == userID && absences[i].Covers(at) {
+			return true
+		}
+	}
+	return false
+}
+
+type AbsenceRepository interface {
+	Create(ctx context.Context, absence *Absence) error
+	GetByID(ctx context.Context, id int64, tenantID int64) (*Absence, error)
+	FetchByUser(ctx context.Context, userID int64, tenantID int64) ([]Absence, error)
+	FetchOverlapping(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]Absence, error)
+	Update(ctx context.Context, absence *Absence) error
+	Delete(ctx context.Context, id int64, tenantID int64) error
+}
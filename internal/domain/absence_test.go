@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestAbsence_OverlapDays(t *testing.T) {
+	ranged := Absence{
+		StartsOn: time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		EndsOn:   timePtr(time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC)),
+	}
+	vacation := Absence{
+		StartsOn: time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name     string
+		absence  Absence
+		from     time.Time
+		to       time.Time
+		expected int
+	}{
+		{
+			name:     "ausência inteira dentro da janela",
+			absence:  ranged,
+			from:     time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC),
+			expected: 5,
+		},
+		{
+			name:     "janela termina no meio da ausência",
+			absence:  ranged,
+			from:     time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC),
+			expected: 2,
+		},
+		{
+			name:     "sem sobreposição",
+			absence:  ranged,
+			from:     time.Date(2026, time.October, 15, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			name:     "férias sem data de retorno ocupam o resto da janela",
+			absence:  vacation,
+			from:     time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC),
+			expected: 11,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if days := tt.absence.OverlapDays(tt.from, tt.to); days != tt.expected {
+				t.Errorf("esperados %d dias, obtidos %d", tt.expected, days)
+			}
+		})
+	}
+}
+
+func TestAbsence_Covers(t *testing.T) {
+	tests := []struct {
+		name     string
+		absence  Absence
+		at       time.Time
+		expected bool
+	}{
+		{
+			name:     "último dia da ausência está coberto",
+			absence:  Absence{StartsOn: time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC), EndsOn: timePtr(time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC))},
+			at:       time.Date(2026, time.October, 14, 23, 0, 0, 0, time.Local),
+			expected: true,
+		},
+		{
+			name:     "dia seguinte ao retorno não está coberto",
+			absence:  Absence{StartsOn: time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC), EndsOn: timePtr(time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC))},
+			at:       time.Date(2026, time.October, 15, 8, 0, 0, 0, time.Local),
+			expected: false,
+		},
+		{
+			name:     "férias cobrem qualquer dia após o início",
+			absence:  Absence{StartsOn: time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC)},
+			at:       time.Date(2027, time.January, 1, 8, 0, 0, 0, time.Local),
+			expected: true,
+		},
+		{
+			name:     "férias não cobrem dias anteriores ao início",
+			absence:  Absence{StartsOn: time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC)},
+			at:       time.Date(2026, time.October, 9, 8, 0, 0, 0, time.Local),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if covered := tt.absence.Covers(tt.at); covered != tt.expected {
+				t.Errorf("cobertura esperada %v, obtida %v", tt.expected, covered)
+			}
+		})
+	}
+}
+
+func TestAbsence_Overlaps(t *testing.T) {
+	base := Absence{
+		StartsOn: time.Date(2026, time.October, 10, 0, 0, 0, 0, time.UTC),
+		EndsOn:   timePtr(time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name     string
+		other    Absence
+		expected bool
+	}{
+		{
+			name:     "períodos encostados no mesmo dia se sobrepõem",
+			other:    Absence{StartsOn: time.Date(2026, time.October, 14, 0, 0, 0, 0, time.UTC), EndsOn: timePtr(time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC))},
+			expected: true,
+		},
+		{
+			name:     "período logo após o retorno não se sobrepõe",
+			other:    Absence{StartsOn: time.Date(2026, time.October, 15, 0, 0, 0, 0, time.UTC), EndsOn: timePtr(time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC))},
+			expected: false,
+		},
+		{
+			name:     "férias abertas antes do período se sobrepõem",
+			other:    Absence{StartsOn: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)},
+			expected: true,
+		},
+		{
+			name:     "férias abertas depois do período não se sobrepõem",
+			other:    Absence{StartsOn: time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if overlaps := base.Overlaps(&tt.other); overlaps != tt.expected {
+				t.Errorf("sobreposição esperada %v, obtida %v", tt.expected, overlaps)
+			}
+			if overlaps := tt.other.Overlaps(&base); overlaps != tt.expected {
+				t.Errorf("sobreposição deveria ser simétrica, obtida %v", overlaps)
+			}
+		})
+	}
+}
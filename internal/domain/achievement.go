@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type AchievementMetric string
+
+const (
+	MetricTasksCompleted      AchievementMetric = "tasks_completed"
+	MetricCompletionStreak    AchievementMetric = "completion_streak"
+	MetricComplimentsSent     AchievementMetric = "compliments_sent"
+	MetricComplimentsReceived AchievementMetric = "compliments_received"
+	MetricOverdueCompleted    AchievementMetric = "overdue_completed"
+)
+
+func (m AchievementMetric) IsValid() bool {
+	switch m {
+	case MetricTasksCompleted, MetricCompletionStreak, MetricComplimentsSent, MetricComplimentsReceived, MetricOverdueCompleted:
+		return true
+	}
+	return false
+}
+
+type AchievementDefinition struct {
+	ID          int64             `json:"id"`
+	TenantID    *int64            `json:"tenant_id"`
+	Code        string            `json:"code"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Metric      AchievementMetric `json:"metric"`
+	Threshold   int               `json:"threshold"`
+	Active      bool              `json:"active"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+func (d *AchievementDefinition) IsGlobal() bool {
+	return d.TenantID == nil
+}
+
+func (d *AchievementDefinition) IsReachedBy(value int) bool {
+	return d.Active && value >= d.Threshold
+}
+
+type UserAchievement struct {
+	ID            int64     `json:"id"`
+	TenantID      int64     `json:"tenant_id"`
+	UserID        int64     `json:"user_id"`
+	AchievementID int64     `json:"achievement_id"`
+	AwardedAt     time.Time `json:"awarded_at"`
+}
+
+type AchievementDefinitionRepository interface {
+	Create(ctx context.Context, definition *AchievementDefinition) error
+	GetByID(ctx context.Context, id int64, tenantID int64) (*AchievementDefinition, error)
+	FetchAll(ctx context.Context, tenantID int64) ([]AchievementDefinition, error)
+	Update(ctx context.Context, definition *AchievementDefinition) error
+	Delete(ctx context.Context, id int64, tenantID int64) error
+}
+
+type AchievementRepository interface {
+	MarkEventProcessed(ctx context.Context, eventID int64, userID int64) (bool, error)
+	IncrementProgress(ctx context.Context, tenantID int64, userID int64, metric AchievementMetric, delta int) (int, error)
+	RecordActivityDay(ctx context.Context, tenantID int64, userID int64, metric AchievementMetric, day time.Time) (int, error)
+	GetProgress(ctx context.Context, userID int64, tenantID int64) (map[AchievementMetric]int, error)
+	Award(ctx context.Context, award *UserAchievement) (bool, error)
+	FetchAwardsByUser(ctx context.Context, userID int64, tenantID int64) ([]UserAchievement, error)
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+)
+
+type ChecklistAction string
+
+const (
+	ChecklistChecked   ChecklistAction = "checked"
+	ChecklistUnchecked ChecklistAction = "unchecked"
+)
+
+type ChecklistItem struct {
+	ID          int64      `json:"id"`
+	TenantID    int64      `json:"tenant_id"`
+	TaskID      int64      `json:"task_id"`
+	Position    int        `json:"position"`
+	Title       string     `json:"title"`
+	CheckedAt   *time.Time `json:"checked_at"`
+	CheckedById *int64     `json:"checked_by_id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CreatedById *int64     `json:"created_by_id"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func (i *ChecklistItem) IsChecked() bool {
+	return i.CheckedAt != nil
+}
+
+type ChecklistAuditEntry struct {
+	ID        int64           `json:"id"`
+	TenantID  int64           `json:"tenant_id"`
+	TaskID    int64           `json:"task_id"`
+	ItemID    *int64          `json:"item_id"`
+	ItemTitle string          `json:"item_title"`
+	UserID    *int64          `json:"user_id"`
+	Action    ChecklistAction `json:"action"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type ChecklistProgress struct {
+	Checked int     `json:"checked"`
+	Total   int     `json:"total"`
+	Percent float64 `json:"percent"`
+}
+
+func ProgressOf(items []ChecklistItem) ChecklistProgress {
+	progress := ChecklistProgress{Total: len(items)}
+	for i := range items {
+		if items[i].IsChecked() {
+			progress.Checked++
+		}
+	}
+
+	if progress.Total > 0 {
+		progress.Percent = math.Round(float64(progress.Checked)*10000/float64(progress.Total)) / 100
+	}
+
+	return progress
+}
+
+func SortChecklist(items []ChecklistItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Position != items[j].Position {
+			return items[i].Position < items[j].Position
+		}
+		return items[i].ID < items[j].ID
+	})
+}
+
+func CopyChecklist(items []ChecklistItem, taskID int64, createdById *int64, now time.Time) []ChecklistItem {
+	sorted := make([]ChecklistItem, len(items))
+	copy(sorted, items)
+	SortChecklist(sorted)
+
+	copies := make([]ChecklistItem, len(sorted))
+	for i, item := range sorted {
+		copies[i] = ChecklistItem{
+			TenantID:    item.TenantID,
+			TaskID:      taskID,
+			Position:    i + 1,
+			Title:       item.Title,
+			CreatedAt:   now,
+			CreatedById: createdById,
+			UpdatedAt:   now,
+		}
+	}
+
+	return copies
+}
+
+type ChecklistRepository interface {
+	Create(ctx context.Context, item *ChecklistItem) error
+	GetByID(ctx context.Context, id int64, tenantID int64) (*ChecklistItem, error)
+	FetchByTask(ctx context.Context, taskID int64, tenantID int64) ([]ChecklistItem, error)
+	Update(ctx context.Context, item *ChecklistItem) error
+	Delete(ctx context.Context, id int64, tenantID int64) error
+	RecordAudit(ctx context.Context, entry *ChecklistAuditEntry) error
+	FetchAudit(ctx context.Context, taskID int64, tenantID int64, limit int, offset int) ([]ChecklistAuditEntry, error)
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProgressOf(t *testing.T) {
+	checkedAt := time.Now()
+
+	tests := []struct {
+		name     string
+		items    []ChecklistItem
+		expected ChecklistProgress
+	}{
+		{
+			name:     "checklist vazia não tem progresso",
+			expected: ChecklistProgress{},
+		},
+		{
+			name: "progresso parcial arredonda em duas casas",
+			items: []ChecklistItem{
+				{ID: 1, CheckedAt: &checkedAt},
+				{ID: 2},
+				{ID: 3},
+			},
+			expected: ChecklistProgress{Checked: 1, Total: 3, Percent: 33.33},
+		},
+		{
+			name: "todos os itens marcados",
+			items: []ChecklistItem{
+				{ID: 1, CheckedAt: &checkedAt},
+				{ID: 2, CheckedAt: &checkedAt},
+			},
+			expected: ChecklistProgress{Checked: 2, Total: 2, Percent: 100},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if progress := ProgressOf(tt.items); progress != tt.expected {
+				t.Errorf("progresso esperado %+v, obtido %+v", tt.expected, progress)
+			}
+		})
+	}
+}
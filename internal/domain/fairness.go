@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+type FairnessInput struct {
+	UserID        int64
+	Name          string
+	Effort        int
+	AvailableDays int
+}
+
+type FairnessShare struct {
+	UserID        int64   `json:"user_id"`
+	Name          string  `json:"name"`
+	Effort        int     `json:"effort"`
+	AvailableDays int     `json:"available_days"`
+	Share         float64 `json:"share"`
+	ExpectedShare float64 `json:"expected_share"`
+	Deficit       float64 `json:"deficit"`
+}
+
+func ComputeFairness(inputs []FairnessInput) []FairnessShare {
+	totalEffort, totalDays := 0, 0
+	for _, input := range inputs {
+		totalEffort += input.Effort
+		totalDays += input.AvailableDays
+	}
+
+	shares := make([]FairnessShare, len(inputs))
+	for i, input := range inputs {
+		share := FairnessShare{
+			UserID:        input.UserID,
+			Name:          input.Name,
+			Effort:        input.Effort,
+			AvailableDays: input.AvailableDays,
+		}
+
+		if totalEffort > 0 {
+			share.Share = float64(input.Effort) * 100 / float64(totalEffort)
+		}
+		if totalDays > 0 {
+			share.ExpectedShare = float64(input.AvailableDays) * 100 / float64(totalDays)
+		} else if len(inputs) > 0 {
+			share.ExpectedShare = 100 / float64(len(inputs))
+		}
+		share.Deficit = share.ExpectedShare - share.Share
+
+		share.Share = roundShare(share.Share)
+		share.ExpectedShare = roundShare(share.ExpectedShare)
+		share.Deficit = roundShare(share.Deficit)
+		shares[i] = share
+	}
+
+	sort.SliceStable(shares, func(i, j int) bool {
+		return lessBehind(shares[i], shares[j])
+	})
+
+	return shares
+}
+
+func MostBehind(shares []FairnessShare, eligible func(userID int64) bool) *FairnessShare {
+	var best *FairnessShare
+	for i := range shares {
+		if !eligible(shares[i].UserID) {
+			continue
+		}
+		if best == nil || lessBehind(shares[i], *best) {
+			best = &shares[i]
+		}
+	}
+	return best
+}
+
+func lessBehind(a FairnessShare, b FairnessShare) bool {
+	if a.Deficit != b.Deficit {
+		return a.Deficit > b.Deficit
+	}
+	if a.Effort != b.Effort {
+		return a.Effort < b.Effort
+	}
+	if a.Name != b.Name {
+		return a.Name < b.Name
+	}
+	return a.UserID < b.UserID
+}
+
+func roundShare(value float64) float64 {
+	return math.Round(value*100) / 100
+}
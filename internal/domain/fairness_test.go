@@ -0,0 +1,106 @@
+package domain
+
+import "testing"
+
+func TestComputeFairness(t *testing.T) {
+	tests := []struct {
+		name             string
+		inputs           []FairnessInput
+		expectedOrder    []int64
+		expectedExpected []float64
+	}{
+		{
+			name: "quem fez menos fica mais atrás",
+			inputs: []FairnessInput{
+				{UserID: 1, Name: "Ana", Effort: 30, AvailableDays: 30},
+				{UserID: 2, Name: "Bruno", Effort: 10, AvailableDays: 30},
+			},
+			expectedOrder:    []int64{2, 1},
+			expectedExpected: []float64{50, 50},
+		},
+		{
+			name: "dias de ausência reduzem a parcela esperada",
+			inputs: []FairnessInput{
+				{UserID: 1, Name: "Ana", Effort: 10, AvailableDays: 10},
+				{UserID: 2, Name: "Bruno", Effort: 10, AvailableDays: 30},
+			},
+			expectedOrder:    []int64{2, 1},
+			expectedExpected: []float64{75, 25},
+		},
+		{
+			name: "sem dias disponíveis divide igualmente",
+			inputs: []FairnessInput{
+				{UserID: 1, Name: "Ana"},
+				{UserID: 2, Name: "Bruno"},
+			},
+			expectedOrder:    []int64{1, 2},
+			expectedExpected: []float64{50, 50},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares := ComputeFairness(tt.inputs)
+
+			if len(shares) != len(tt.expectedOrder) {
+				t.Fatalf("esperados %d membros, obtidos %d", len(tt.expectedOrder), len(shares))
+			}
+			for i, share := range shares {
+				if share.UserID != tt.expectedOrder[i] {
+					t.Errorf("posição %d: esperado usuário %d, obtido %d", i, tt.expectedOrder[i], share.UserID)
+				}
+				if share.ExpectedShare != tt.expectedExpected[i] {
+					t.Errorf("posição %d: parcela esperada %.2f, obtida %.2f", i, tt.expectedExpected[i], share.ExpectedShare)
+				}
+				if share.Deficit != share.ExpectedShare-share.Share {
+					t.Errorf("posição %d: déficit inconsistente %+v", i, share)
+				}
+			}
+		})
+	}
+}
+
+func TestMostBehind(t *testing.T) {
+	shares := ComputeFairness([]FairnessInput{
+		{UserID: 1, Name: "Ana", Effort: 30, AvailableDays: 30},
+		{UserID: 2, Name: "Bruno", Effort: 0, AvailableDays: 30},
+		{UserID: 3, Name: "Carla", Effort: 10, AvailableDays: 30},
+	})
+
+	tests := []struct {
+		name     string
+		eligible map[int64]bool
+		expected int64
+	}{
+		{
+			name:     "escolhe quem está mais atrás",
+			eligible: map[int64]bool{1: true, 2: true, 3: true},
+			expected: 2,
+		},
+		{
+			name:     "ignora membros não elegíveis",
+			eligible: map[int64]bool{1: true, 3: true},
+			expected: 3,
+		},
+		{
+			name:     "nenhum elegível",
+			eligible: map[int64]bool{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			best := MostBehind(shares, func(userID int64) bool { return tt.eligible[userID] })
+
+			if tt.expected == 0 {
+				if best != nil {
+					t.Fatalf("nenhum membro esperado, obtido %d", best.UserID)
+				}
+				return
+			}
+			if best == nil || best.UserID != tt.expected {
+				t.Fatalf("esperado usuário %d, obtido %+v", tt.expected, best)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Invitation struct {
+	ID           int64      `json:"id"`
+	TenantID     int64      `json:"tenant_id"`
+	Email        string     `json:"email"`
+	Role         string     `json:"role"`
+	TokenHash    string     `json:"-"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	AcceptedAt   *time.Time `json:"accepted_at"`
+	AcceptedById *int64     `json:"accepted_by_id"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	CreatedById  int64      `json:"created_by_id"`
+}
+
+func (i *Invitation) IsPending(now time.Time) bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && now.Before(i.ExpiresAt)
+}
+
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *Invitation) error
+	GetByID(ctx context.Context, id int64, tenantID int64) (*Invitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*Invitation, error)
+	FetchPending(ctx context.Context, tenantID int64) ([]Invitation, error)
+	Claim(ctx context.Context, id int64, acceptedAt time.Time) error
+	MarkAccepted(ctx context.Context, id int64, userID int64) error
+	Revoke(ctx context.Context, id int64, tenantID int64) error
+	RevokePendingByEmail(ctx context.Context, email string, tenantID int64) error
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+type LeaderboardPeriod string
+
+const (
+	LeaderboardWeek      LeaderboardPeriod = "week"
+	LeaderboardMonth     LeaderboardPeriod = "month"
+	LeaderboardRolling30 LeaderboardPeriod = "rolling_30d"
+	LeaderboardCustom    LeaderboardPeriod = "custom"
+)
+
+var ErrInvalidLeaderboardWindow = errors.New("invalid leaderboard window")
+
+var LeaderboardReasons = []PointsReason{
+	PointsReasonTaskCompleted,
+	PointsReasonTaskUndone,
+	PointsReasonComplimentReceived,
+}
+
+func (p LeaderboardPeriod) IsValid() bool {
+	switch p {
+	case LeaderboardWeek, LeaderboardMonth, LeaderboardRolling30, LeaderboardCustom:
+		return true
+	}
+	return false
+}
+
+type LeaderboardWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (w LeaderboardWindow) UTC() LeaderboardWindow {
+	return LeaderboardWindow{Start: w.Start.UTC(), End: w.End.UTC()}
+}
+
+func ParseLeaderboardTime(value string, location *time.Location) (time.Time, bool, error) {
+	if parsed, err := time.ParseInLocation("2006-01-02", value, location); err == nil {
+		return parsed, true, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	return parsed, false, err
+}
+
+func ResolveLeaderboardWindows(period LeaderboardPeriod, now time.Time, from *time.Time, to *time.Time) (LeaderboardWindow, LeaderboardWindow, error) {
+	var current LeaderboardWindow
+
+	switch period {
+	case LeaderboardWeek:
+		midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		start := midnight.AddDate(0, 0, -((int(now.Weekday()) + 6) % 7))
+		current = LeaderboardWindow{Start: start, End: start.AddDate(0, 0, 7)}
+		return current, LeaderboardWindow{Start: start.AddDate(0, 0, -7), End: start}, nil
+	case LeaderboardMonth:
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		current = LeaderboardWindow{Start: start, End: start.AddDate(0, 1, 0)}
+		return current, LeaderboardWindow{Start: start.AddDate(0, -1, 0), End: start}, nil
+	case LeaderboardRolling30:
+		current = LeaderboardWindow{Start: now.AddDate(0, 0, -30), End: now}
+	case LeaderboardCustom:
+		if from == nil || to == nil || !to.After(*from) {
+			return LeaderboardWindow{}, LeaderboardWindow{}, ErrInvalidLeaderboardWindow
+		}
+		current = LeaderboardWindow{Start: *from, End: *to}
+	default:
+		return LeaderboardWindow{}, LeaderboardWindow{}, ErrInvalidLeaderboardWindow
+	}
+
+	length := current.End.Sub(current.Start)
+	return current, LeaderboardWindow{Start: current.Start.Add(-length), End: current.Start}, nil
+}
+
+type LeaderboardScore struct {
+	UserID int64
+	Name   string
+	Points int
+}
+
+type RankedScore struct {
+	LeaderboardScore
+	Rank int
+}
+
+func RankScores(scores []LeaderboardScore) []RankedScore {
+	sorted := make([]LeaderboardScore, len(scores))
+	copy(sorted, scores)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Points != sorted[j].Points {
+			return sorted[i].Points > sorted[j].Points
+		}
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].UserID < sorted[j].UserID
+	})
+
+	ranked := make([]RankedScore, len(sorted))
+	for i, score := range sorted {
+		rank := i + 1
+		if i > 0 && score.Points == sorted[i-1].Points {
+			rank = ranked[i-1].Rank
+		}
+		ranked[i] = RankedScore{LeaderboardScore: score, Rank: rank}
+	}
+
+	return ranked
+}
+
+func TopRanked(ranked []RankedScore, limit int) []RankedScore {
+	if limit <= 0 || len(ranked) <= limit {
+		return ranked
+	}
+
+	cutoff := ranked[limit-1].Rank
+	end := limit
+	for end < len(ranked) && ranked[end].Rank == cutoff {
+		end++
+	}
+
+	return ranked[:end]
+}
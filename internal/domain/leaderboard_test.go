@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveLeaderboardWindows(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 14, 30, 0, 0, time.UTC)
+	from := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.October, 11, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		period           LeaderboardPeriod
+		from             *time.Time
+		to               *time.Time
+		expectedCurrent  LeaderboardWindow
+		expectedPrevious LeaderboardWindow
+		expectError      bool
+	}{
+		{
+			name:   "semana começa na segunda-feira",
+			period: LeaderboardWeek,
+			expectedCurrent: LeaderboardWindow{
+				Start: time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+			},
+			expectedPrevious: LeaderboardWindow{
+				Start: time.Date(2026, time.October, 5, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, time.October, 12, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:   "mês anterior respeita o calendário",
+			period: LeaderboardMonth,
+			expectedCurrent: LeaderboardWindow{
+				Start: time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectedPrevious: LeaderboardWindow{
+				Start: time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:   "janela móvel de 30 dias termina agora",
+			period: LeaderboardRolling30,
+			expectedCurrent: LeaderboardWindow{
+				Start: now.AddDate(0, 0, -30),
+				End:   now,
+			},
+			expectedPrevious: LeaderboardWindow{
+				Start: now.AddDate(0, 0, -60),
+				End:   now.AddDate(0, 0, -30),
+			},
+		},
+		{
+			name:             "intervalo customizado compara com período de mesmo tamanho",
+			period:           LeaderboardCustom,
+			from:             &from,
+			to:               &to,
+			expectedCurrent:  LeaderboardWindow{Start: from, End: to},
+			expectedPrevious: LeaderboardWindow{Start: from.AddDate(0, 0, -10), End: from},
+		},
+		{
+			name:        "intervalo customizado exige início e fim",
+			period:      LeaderboardCustom,
+			from:        &from,
+			expectError: true,
+		},
+		{
+			name:        "intervalo customizado rejeita fim antes do início",
+			period:      LeaderboardCustom,
+			from:        &to,
+			to:          &from,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, previous, err := ResolveLeaderboardWindows(tt.period, now, tt.from, tt.to)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("erro esperado, obtido nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if !current.Start.Equal(tt.expectedCurrent.Start) || !current.End.Equal(tt.expectedCurrent.End) {
+				t.Errorf("janela atual esperada %v, obtida %v", tt.expectedCurrent, current)
+			}
+			if !previous.Start.Equal(tt.expectedPrevious.Start) || !previous.End.Equal(tt.expectedPrevious.End) {
+				t.Errorf("janela anterior esperada %v, obtida %v", tt.expectedPrevious, previous)
+			}
+		})
+	}
+}
+
+func TestLeaderboardWindow_UTC(t *testing.T) {
+	location := time.FixedZone("BRT", -3*60*60)
+	now := time.Date(2024, 3, 6, 22, 0, 0, 0, location)
+
+	current, _, err := ResolveLeaderboardWindows(LeaderboardWeek, now, nil, nil)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	stored := current.UTC()
+	expectedStart := time.Date(2024, 3, 4, 3, 0, 0, 0, time.UTC)
+	if stored.Start.Location() != time.UTC || !stored.Start.Equal(expectedStart) {
+		t.Errorf("início esperado %v, obtido %v", expectedStart, stored.Start)
+	}
+	if stored.Start.Hour() != 3 {
+		t.Errorf("hora em UTC esperada 3, obtida %d", stored.Start.Hour())
+	}
+}
+
+func TestRankScores(t *testing.T) {
+	scores := []LeaderboardScore{
+		{UserID: 1, Name: "Ana", Points: 30},
+		{UserID: 2, Name: "Bruno", Points: 50},
+		{UserID: 3, Name: "Carla", Points: 30},
+		{UserID: 4, Name: "Davi", Points: 10},
+		{UserID: 5, Name: "Eva", Points: 0},
+	}
+
+	tests := []struct {
+		name          string
+		limit         int
+		expectedIDs   []int64
+		expectedRanks []int
+	}{
+		{
+			name:          "empates compartilham a posição e pulam a seguinte",
+			limit:         0,
+			expectedIDs:   []int64{2, 1, 3, 4, 5},
+			expectedRanks: []int{1, 2, 2, 4, 5},
+		},
+		{
+			name:          "limite inclui todos os empatados na última posição",
+			limit:         2,
+			expectedIDs:   []int64{2, 1, 3},
+			expectedRanks: []int{1, 2, 2},
+		},
+		{
+			name:          "limite sem empate na fronteira corta normalmente",
+			limit:         4,
+			expectedIDs:   []int64{2, 1, 3, 4},
+			expectedRanks: []int{1, 2, 2, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranked := TopRanked(RankScores(scores), tt.limit)
+
+			if len(ranked) != len(tt.expectedIDs) {
+				t.Fatalf("esperadas %d posições, obtidas %d", len(tt.expectedIDs), len(ranked))
+			}
+			for i, entry := range ranked {
+				if entry.UserID != tt.expectedIDs[i] || entry.Rank != tt.expectedRanks[i] {
+					t.Errorf("posição %d: esperado usuário %d no rank %d, obtido usuário %d no rank %d", i, tt.expectedIDs[i], tt.expectedRanks[i], entry.UserID, entry.Rank)
+				}
+			}
+		})
+	}
+}
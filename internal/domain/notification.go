@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type Notification struct {
+	ID        int64      `json:"id"`
+	TenantID  int64      `json:"tenant_id"`
+	UserID    int64      `json:"user_id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	TaskID    *int64     `json:"task_id"`
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *Notification) error
+	FetchByUser(ctx context.Context, userID int64, tenantID int64, unreadOnly bool, limit int, offset int) ([]Notification, error)
+	CountUnread(ctx context.Context, userID int64, tenantID int64) (int, error)
+	MarkAsRead(ctx context.Context, ids []int64, userID int64, tenantID int64) error
+	MarkAllAsRead(ctx context.Context, userID int64, tenantID int64) error
+}
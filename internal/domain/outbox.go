@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type OutboxStatus string
+
+const (
+	OutboxPending   OutboxStatus = "pending"
+	OutboxDelivered OutboxStatus = "delivered"
+	OutboxDead      OutboxStatus = "dead"
+)
+
+type OutboxEvent struct {
+	ID          int64
+	EventType   string
+	Payload     []byte
+	Status      OutboxStatus
+	Attempts    int
+	LastError   *string
+	OccurredAt  time.Time
+	AvailableAt time.Time
+	DeliveredAt *time.Time
+	CreatedAt   time.Time
+}
+
+type OutboxRepository interface {
+	Create(ctx context.Context, event *OutboxEvent) error
+	GetByID(ctx context.Context, id int64) (*OutboxEvent, error)
+	ClaimPending(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]OutboxEvent, error)
+	MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error
+	MarkFailed(ctx context.Context, id int64, attempts int, lastError string, availableAt time.Time, dead bool) error
+	DeliveredHandlers(ctx context.Context, id int64) ([]string, error)
+	MarkHandlerDelivered(ctx context.Context, id int64, handler string, deliveredAt time.Time) error
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var ErrInsufficientPoints = errors.New("insufficient points")
+
+type PointsReason string
+
+const (
+	PointsReasonOpeningBalance     PointsReason = "opening_balance"
+	PointsReasonTaskCompleted      PointsReason = "task_completed"
+	PointsReasonTaskUndone         PointsReason = "task_undone"
+	PointsReasonComplimentReceived PointsReason = "compliment_received"
+	PointsReasonAdjustment         PointsReason = "adjustment"
+	PointsReasonRewardRedeemed     PointsReason = "reward_redeemed"
+	PointsReasonRewardRefunded     PointsReason = "reward_refunded"
+)
+
+type PointsSource string
+
+const (
+	PointsSourceTask       PointsSource = "task"
+	PointsSourceCompliment PointsSource = "compliment"
+	PointsSourceUser       PointsSource = "user"
+	PointsSourceRedemption PointsSource = "redemption"
+)
+
+type PointsEntry struct {
+	ID             int64        `json:"id"`
+	TenantID       int64        `json:"tenant_id"`
+	UserID         int64        `json:"user_id"`
+	Delta          int          `json:"delta"`
+	Reason         PointsReason `json:"reason"`
+	SourceType     PointsSource `json:"source_type"`
+	SourceID       int64        `json:"source_id"`
+	IdempotencyKey string       `json:"-"`
+	CreatedAt      time.Time    `json:"created_at"`
+}
+
+type PointsLedgerRepository interface {
+	Post(ctx context.Context, entry *PointsEntry) (bool, error)
+	Spend(ctx context.Context, entry *PointsEntry) (bool, error)
+	FetchByUser(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]PointsEntry, error)
+	GetBalance(ctx context.Context, userID int64, tenantID int64) (int, error)
+	SumByUser(ctx context.Context, tenantID int64, from time.Time, to time.Time, reasons []PointsReason) (map[int64]int, error)
+}
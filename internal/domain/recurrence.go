@@ -0,0 +1,404 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceMonthly RecurrenceFrequency = "MONTHLY"
+	RecurrenceYearly  RecurrenceFrequency = "YEARLY"
+)
+
+const maxRecurrencePeriods = 50000
+
+var ErrInvalidRecurrenceRule = errors.New("invalid recurrence rule")
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+type RecurrenceWeekday struct {
+	Weekday time.Weekday
+	Ordinal int
+}
+
+func (d RecurrenceWeekday) String() string {
+	code := weekdayNames[d.Weekday]
+	if d.Ordinal == 0 {
+		return code
+	}
+	return strconv.Itoa(d.Ordinal) + code
+}
+
+type RecurrenceRule struct {
+	Frequency  RecurrenceFrequency
+	Interval   int
+	ByDay      []RecurrenceWeekday
+	ByMonthDay []int
+	Until      *time.Time
+	Count      int
+}
+
+func ParseRecurrenceRule(value string) (*RecurrenceRule, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(strings.ToUpper(value), "RRULE:")
+	if value == "" {
+		return nil, invalidRecurrence("rule is empty")
+	}
+
+	rule := &RecurrenceRule{Interval: 1}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, invalidRecurrence("malformed part %s", part)
+		}
+		if seen[key] {
+			return nil, invalidRecurrence("duplicated %s", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			rule.Frequency = RecurrenceFrequency(val)
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, invalidRecurrence("INTERVAL must be a number")
+			}
+			rule.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, invalidRecurrence("COUNT must be a number")
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := parseRecurrenceTime(val)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, item := range strings.Split(val, ",") {
+				weekday, err := parseRecurrenceWeekday(item)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+		case "BYMONTHDAY":
+			for _, item := range strings.Split(val, ",") {
+				day, err := strconv.Atoi(item)
+				if err != nil {
+					return nil, invalidRecurrence("BYMONTHDAY must be a list of numbers")
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, day)
+			}
+		case "WKST":
+			if val != "MO" {
+				return nil, invalidRecurrence("only WKST=MO is supported")
+			}
+		default:
+			return nil, invalidRecurrence("unsupported part %s", key)
+		}
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r *RecurrenceRule) Validate() error {
+	switch r.Frequency {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceYearly:
+	case "":
+		return invalidRecurrence("FREQ is required")
+	default:
+		return invalidRecurrence("unsupported FREQ %s", r.Frequency)
+	}
+
+	if r.Interval < 1 {
+		return invalidRecurrence("INTERVAL must be at least 1")
+	}
+
+	if r.Count < 0 {
+		return invalidRecurrence("COUNT must be positive")
+	}
+
+	if r.Count > 0 && r.Until != nil {
+		return invalidRecurrence("COUNT and UNTIL cannot be combined")
+	}
+
+	for _, day := range r.ByDay {
+		if day.Ordinal != 0 && r.Frequency != RecurrenceMonthly {
+			return invalidRecurrence("BYDAY ordinals are only supported with FREQ=MONTHLY")
+		}
+		if day.Ordinal < -5 || day.Ordinal > 5 {
+			return invalidRecurrence("BYDAY ordinal must be between -5 and 5")
+		}
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		if r.Frequency != RecurrenceMonthly {
+			return invalidRecurrence("BYMONTHDAY is only supported with FREQ=MONTHLY")
+		}
+		if len(r.ByDay) > 0 {
+			return invalidRecurrence("BYMONTHDAY and BYDAY cannot be combined")
+		}
+		for _, day := range r.ByMonthDay {
+			if day == 0 || day < -31 || day > 31 {
+				return invalidRecurrence("BYMONTHDAY must be between -31 and 31")
+			}
+		}
+	}
+
+	if r.Frequency == RecurrenceYearly && len(r.ByDay) > 0 {
+		return invalidRecurrence("BYDAY is not supported with FREQ=YEARLY")
+	}
+
+	return nil
+}
+
+func (r *RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = day.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, day := range r.ByMonthDay {
+			days[i] = strconv.Itoa(day)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func (r *RecurrenceRule) Next(start time.Time, after time.Time) (time.Time, bool) {
+	emitted := 0
+
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, occurrence := range r.occurrencesInPeriod(start, period) {
+			if occurrence.Before(start) {
+				continue
+			}
+			if r.Until != nil && occurrence.After(*r.Until) {
+				return time.Time{}, false
+			}
+
+			emitted++
+			if r.Count > 0 && emitted > r.Count {
+				return time.Time{}, false
+			}
+
+			if occurrence.After(after) {
+				return occurrence, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (r *RecurrenceRule) occurrencesInPeriod(start time.Time, period int) []time.Time {
+	hour, minute, second := start.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, minute, second, 0, start.Location())
+	}
+
+	var occurrences []time.Time
+	step := period * r.Interval
+
+	switch r.Frequency {
+	case RecurrenceDaily:
+		day := at(start.Year(), start.Month(), start.Day()+step)
+		if len(r.ByDay) == 0 || r.matchesWeekday(day.Weekday()) {
+			occurrences = append(occurrences, day)
+		}
+
+	case RecurrenceWeekly:
+		offset := (int(start.Weekday()) + 6) % 7
+		monday := at(start.Year(), start.Month(), start.Day()-offset+step*7)
+		if len(r.ByDay) == 0 {
+			occurrences = append(occurrences, monday.AddDate(0, 0, offset))
+			break
+		}
+		for i := 0; i < 7; i++ {
+			day := monday.AddDate(0, 0, i)
+			if r.matchesWeekday(day.Weekday()) {
+				occurrences = append(occurrences, day)
+			}
+		}
+
+	case RecurrenceMonthly:
+		first := at(start.Year(), start.Month()+time.Month(step), 1)
+		year, month := first.Year(), first.Month()
+		daysInMonth := first.AddDate(0, 1, -1).Day()
+
+		switch {
+		case len(r.ByMonthDay) > 0:
+			for _, day := range r.ByMonthDay {
+				if day < 0 {
+					day = daysInMonth + day + 1
+				}
+				if day >= 1 && day <= daysInMonth {
+					occurrences = append(occurrences, at(year, month, day))
+				}
+			}
+		case len(r.ByDay) > 0:
+			for _, weekday := range r.ByDay {
+				for _, day := range weekdaysInMonth(year, month, daysInMonth, weekday) {
+					occurrences = append(occurrences, at(year, month, day))
+				}
+			}
+		default:
+			if start.Day() <= daysInMonth {
+				occurrences = append(occurrences, at(year, month, start.Day()))
+			}
+		}
+
+	case RecurrenceYearly:
+		year := start.Year() + step
+		candidate := at(year, start.Month(), start.Day())
+		if candidate.Month() == start.Month() {
+			occurrences = append(occurrences, candidate)
+		}
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].Before(occurrences[j])
+	})
+
+	return dedupeTimes(occurrences)
+}
+
+func (r *RecurrenceRule) matchesWeekday(weekday time.Weekday) bool {
+	for _, day := range r.ByDay {
+		if day.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func weekdaysInMonth(year int, month time.Month, daysInMonth int, weekday RecurrenceWeekday) []int {
+	var days []int
+	for day := 1; day <= daysInMonth; day++ {
+		if time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() == weekday.Weekday {
+			days = append(days, day)
+		}
+	}
+
+	if weekday.Ordinal == 0 {
+		return days
+	}
+
+	index := weekday.Ordinal - 1
+	if weekday.Ordinal < 0 {
+		index = len(days) + weekday.Ordinal
+	}
+
+	if index < 0 || index >= len(days) {
+		return nil
+	}
+
+	return []int{days[index]}
+}
+
+func dedupeTimes(times []time.Time) []time.Time {
+	if len(times) < 2 {
+		return times
+	}
+
+	unique := times[:1]
+	for _, t := range times[1:] {
+		if !t.Equal(unique[len(unique)-1]) {
+			unique = append(unique, t)
+		}
+	}
+
+	return unique
+}
+
+func parseRecurrenceWeekday(value string) (RecurrenceWeekday, error) {
+	if len(value) < 2 {
+		return RecurrenceWeekday{}, invalidRecurrence("invalid BYDAY value %s", value)
+	}
+
+	code := value[len(value)-2:]
+	weekday, ok := weekdayCodes[code]
+	if !ok {
+		return RecurrenceWeekday{}, invalidRecurrence("invalid BYDAY value %s", value)
+	}
+
+	ordinal := 0
+	if prefix := value[:len(value)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 {
+			return RecurrenceWeekday{}, invalidRecurrence("invalid BYDAY value %s", value)
+		}
+		ordinal = n
+	}
+
+	return RecurrenceWeekday{Weekday: weekday, Ordinal: ordinal}, nil
+}
+
+func parseRecurrenceTime(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	return time.Time{}, invalidRecurrence("UNTIL must be a date (YYYYMMDD) or UTC date-time")
+}
+
+func invalidRecurrence(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRecurrenceRule, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type RecurrenceAnchor string
+
+const (
+	AnchorCompletion RecurrenceAnchor = "completion"
+	AnchorSchedule   RecurrenceAnchor = "schedule"
+)
+
+func (a RecurrenceAnchor) IsValid() bool {
+	return a == AnchorCompletion || a == AnchorSchedule
+}
+
+type recurrence struct {
+	frequencyValue int
+	frequencyUnit  FrequencyUnit
+	rule           *string
+	anchor         RecurrenceAnchor
+	start          time.Time
+}
+
+func (r recurrence) next(scheduledTo *time.Time, completedAt time.Time) (time.Time, bool, error) {
+	fixed := r.anchor == AnchorSchedule && scheduledTo != nil
+
+	if r.rule != nil {
+		rule, err := ParseRecurrenceRule(*r.rule)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+
+		after := completedAt
+		if fixed && scheduledTo.After(after) {
+			after = *scheduledTo
+		}
+
+		next, ok := rule.Next(r.ruleStart(scheduledTo, completedAt), after)
+		return next, ok, nil
+	}
+
+	if err := r.validateFrequency(); err != nil {
+		return time.Time{}, false, err
+	}
+
+	if !fixed {
+		next, err := addFrequency(completedAt, r.frequencyUnit, r.frequencyValue)
+		return next, err == nil, err
+	}
+
+	for periods := 1; ; periods++ {
+		next, err := addFrequency(*scheduledTo, r.frequencyUnit, r.frequencyValue*periods)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if next.After(completedAt) {
+			return next, true, nil
+		}
+	}
+}
+
+func (r recurrence) previous(scheduledTo *time.Time, nextDueDate time.Time) (time.Time, error) {
+	fixed := r.anchor == AnchorSchedule && scheduledTo != nil
+
+	if r.rule != nil {
+		if !fixed {
+			return time.Time{}, errors.New("previous due date is not defined for completion-relative recurrence rules")
+		}
+
+		rule, err := ParseRecurrenceRule(*r.rule)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		start := r.ruleStart(scheduledTo, nextDueDate)
+		var previous time.Time
+		cursor := start.Add(-time.Nanosecond)
+		for {
+			occurrence, ok := rule.Next(start, cursor)
+			if !ok || !occurrence.Before(nextDueDate) {
+				break
+			}
+			previous = occurrence
+			cursor = occurrence
+		}
+
+		if previous.IsZero() {
+			return time.Time{}, errors.New("no occurrence before the given date")
+		}
+		return previous, nil
+	}
+
+	if err := r.validateFrequency(); err != nil {
+		return time.Time{}, err
+	}
+
+	if !fixed || !nextDueDate.After(*scheduledTo) {
+		return addFrequency(nextDueDate, r.frequencyUnit, -r.frequencyValue)
+	}
+
+	previous := *scheduledTo
+	for periods := 1; ; periods++ {
+		candidate, err := addFrequency(*scheduledTo, r.frequencyUnit, r.frequencyValue*periods)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !candidate.Before(nextDueDate) {
+			return previous, nil
+		}
+		previous = candidate
+	}
+}
+
+func (r recurrence) ruleStart(scheduledTo *time.Time, fallback time.Time) time.Time {
+	if !r.start.IsZero() {
+		return r.start
+	}
+	if scheduledTo != nil {
+		return *scheduledTo
+	}
+	return fallback
+}
+
+func (r recurrence) validateFrequency() error {
+	if r.frequencyUnit == "" || r.frequencyValue <= 0 {
+		return errors.New("frequency unit or frequency value not defined")
+	}
+	return nil
+}
+
+func addFrequency(date time.Time, unit FrequencyUnit, value int) (time.Time, error) {
+	switch unit {
+	case UnitDays:
+		return date.AddDate(0, 0, value), nil
+	case UnitWeeks:
+		return date.AddDate(0, 0, value*7), nil
+	case UnitMonths:
+		return date.AddDate(0, value, 0), nil
+	default:
+		return time.Time{}, errors.New("invalid frequency unit")
+	}
+}
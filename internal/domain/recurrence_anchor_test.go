@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTask_CalculateNextDueDate_AnchorMode(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 9, 0, 0, 0, time.UTC)
+	}
+	scheduled := date(time.October, 1)
+	weekly := "FREQ=WEEKLY;BYDAY=TH"
+
+	tests := []struct {
+		name        string
+		anchor      RecurrenceAnchor
+		rule        *string
+		completedAt time.Time
+		expected    time.Time
+	}{
+		{
+			name:        "conclusão: próxima data a partir da conclusão",
+			anchor:      AnchorCompletion,
+			completedAt: date(time.October, 3),
+			expected:    date(time.October, 10),
+		},
+		{
+			name:        "agenda: conclusão atrasada mantém a grade",
+			anchor:      AnchorSchedule,
+			completedAt: date(time.October, 3),
+			expected:    date(time.October, 8),
+		},
+		{
+			name:        "agenda: períodos perdidos são pulados",
+			anchor:      AnchorSchedule,
+			completedAt: date(time.October, 20),
+			expected:    date(time.October, 22),
+		},
+		{
+			name:        "agenda: conclusão antecipada não adianta a grade",
+			anchor:      AnchorSchedule,
+			completedAt: date(time.September, 28),
+			expected:    date(time.October, 8),
+		},
+		{
+			name:        "regra com âncora na agenda usa a data agendada",
+			anchor:      AnchorSchedule,
+			rule:        &weekly,
+			completedAt: date(time.September, 28),
+			expected:    date(time.October, 8),
+		},
+		{
+			name:        "regra com âncora na conclusão usa a data de conclusão",
+			anchor:      AnchorCompletion,
+			rule:        &weekly,
+			completedAt: date(time.September, 28),
+			expected:    date(time.October, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{
+				ScheduledTo:    &scheduled,
+				FrequencyValue: 1,
+				FrequencyUnit:  UnitWeeks,
+				RecurrenceRule: tt.rule,
+				AnchorMode:     tt.anchor,
+			}
+
+			next, err := task.CalculateNextDueDate(tt.completedAt)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if !next.Equal(tt.expected) {
+				t.Errorf("próxima data esperada %v, obtida %v", tt.expected, next)
+			}
+		})
+	}
+}
+
+func TestTask_CalculatePreviousDueDate_AnchorMode(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 9, 0, 0, 0, time.UTC)
+	}
+	scheduled := date(time.October, 1)
+
+	tests := []struct {
+		name     string
+		anchor   RecurrenceAnchor
+		next     time.Time
+		expected time.Time
+	}{
+		{
+			name:     "conclusão: subtrai a frequência",
+			anchor:   AnchorCompletion,
+			next:     date(time.October, 10),
+			expected: date(time.October, 3),
+		},
+		{
+			name:     "agenda: volta para o slot anterior da grade",
+			anchor:   AnchorSchedule,
+			next:     date(time.October, 22),
+			expected: date(time.October, 15),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &Task{
+				ScheduledTo:    &scheduled,
+				FrequencyValue: 1,
+				FrequencyUnit:  UnitWeeks,
+				AnchorMode:     tt.anchor,
+			}
+
+			previous, err := task.CalculatePreviousDueDate(tt.next)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if !previous.Equal(tt.expected) {
+				t.Errorf("data anterior esperada %v, obtida %v", tt.expected, previous)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expected      string
+		expectedError bool
+	}{
+		{name: "segunda e quinta", value: "FREQ=WEEKLY;BYDAY=MO,TH", expected: "FREQ=WEEKLY;BYDAY=MO,TH"},
+		{name: "último sábado do mês", value: "RRULE:FREQ=MONTHLY;BYDAY=-1SA", expected: "FREQ=MONTHLY;BYDAY=-1SA"},
+		{name: "a cada 2 semanas no domingo", value: "freq=weekly;interval=2;byday=su", expected: "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU"},
+		{name: "com contagem", value: "FREQ=DAILY;COUNT=3", expected: "FREQ=DAILY;COUNT=3"},
+		{name: "com data final", value: "FREQ=DAILY;UNTIL=20261231T100000Z", expected: "FREQ=DAILY;UNTIL=20261231T100000Z"},
+		{name: "erro sem FREQ", value: "BYDAY=MO", expectedError: true},
+		{name: "erro com COUNT e UNTIL", value: "FREQ=DAILY;COUNT=2;UNTIL=20261231", expectedError: true},
+		{name: "erro com ordinal fora de MONTHLY", value: "FREQ=WEEKLY;BYDAY=1MO", expectedError: true},
+		{name: "erro com dia inválido", value: "FREQ=WEEKLY;BYDAY=XX", expectedError: true},
+		{name: "erro com parte não suportada", value: "FREQ=DAILY;BYHOUR=10", expectedError: true},
+		{name: "erro com intervalo zero", value: "FREQ=DAILY;INTERVAL=0", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.value)
+
+			if tt.expectedError {
+				if !errors.Is(err, ErrInvalidRecurrenceRule) {
+					t.Errorf("erro esperado ErrInvalidRecurrenceRule, obtido '%v'", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if rule.String() != tt.expected {
+				t.Errorf("regra esperada '%s', obtida '%s'", tt.expected, rule.String())
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_Next(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 9, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		rule       string
+		start      time.Time
+		after      time.Time
+		expected   time.Time
+		expectedOk bool
+	}{
+		{
+			name:       "segunda e quinta a partir de uma terça",
+			rule:       "FREQ=WEEKLY;BYDAY=MO,TH",
+			start:      date(2026, time.October, 5),
+			after:      date(2026, time.October, 6),
+			expected:   date(2026, time.October, 8),
+			expectedOk: true,
+		},
+		{
+			name:       "segunda e quinta a partir de uma sexta",
+			rule:       "FREQ=WEEKLY;BYDAY=MO,TH",
+			start:      date(2026, time.October, 5),
+			after:      date(2026, time.October, 9),
+			expected:   date(2026, time.October, 12),
+			expectedOk: true,
+		},
+		{
+			name:       "último sábado do mês",
+			rule:       "FREQ=MONTHLY;BYDAY=-1SA",
+			start:      date(2026, time.October, 1),
+			after:      date(2026, time.October, 31),
+			expected:   date(2026, time.November, 28),
+			expectedOk: true,
+		},
+		{
+			name:       "a cada 2 semanas no domingo",
+			rule:       "FREQ=WEEKLY;INTERVAL=2;BYDAY=SU",
+			start:      date(2026, time.October, 4),
+			after:      date(2026, time.October, 4),
+			expected:   date(2026, time.October, 18),
+			expectedOk: true,
+		},
+		{
+			name:       "dia 31 pula meses mais curtos",
+			rule:       "FREQ=MONTHLY;BYMONTHDAY=31",
+			start:      date(2026, time.January, 31),
+			after:      date(2026, time.January, 31),
+			expected:   date(2026, time.March, 31),
+			expectedOk: true,
+		},
+		{
+			name:       "contagem esgotada",
+			rule:       "FREQ=DAILY;COUNT=3",
+			start:      date(2026, time.October, 1),
+			after:      date(2026, time.October, 3),
+			expectedOk: false,
+		},
+		{
+			name:       "data final é inclusiva",
+			rule:       "FREQ=WEEKLY;UNTIL=20261015",
+			start:      date(2026, time.October, 1),
+			after:      date(2026, time.October, 8),
+			expected:   date(2026, time.October, 15),
+			expectedOk: true,
+		},
+		{
+			name:       "data final atingida",
+			rule:       "FREQ=WEEKLY;UNTIL=20261015",
+			start:      date(2026, time.October, 1),
+			after:      date(2026, time.October, 15),
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.rule)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			next, ok := rule.Next(tt.start, tt.after)
+			if ok != tt.expectedOk {
+				t.Fatalf("ok esperado %v, obtido %v (%v)", tt.expectedOk, ok, next)
+			}
+
+			if ok && !next.Equal(tt.expected) {
+				t.Errorf("próxima ocorrência esperada %v, obtida %v", tt.expected, next)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type ReminderTrigger string
+
+const (
+	ReminderBeforeDue ReminderTrigger = "before_due"
+	ReminderOverdue   ReminderTrigger = "overdue"
+)
+
+func (t ReminderTrigger) IsValid() bool {
+	return t == ReminderBeforeDue || t == ReminderOverdue
+}
+
+type ReminderChannel string
+
+const (
+	ChannelInApp   ReminderChannel = "in_app"
+	ChannelEmail   ReminderChannel = "email"
+	ChannelWebhook ReminderChannel = "webhook"
+)
+
+func (c ReminderChannel) IsValid() bool {
+	return c == ChannelInApp || c == ChannelEmail || c == ChannelWebhook
+}
+
+type Reminder struct {
+	ID            int64           `json:"id"`
+	TenantID      int64           `json:"tenant_id"`
+	UserID        int64           `json:"user_id"`
+	TaskID        *int64          `json:"task_id"`
+	SeriesID      *int64          `json:"series_id"`
+	Trigger       ReminderTrigger `json:"trigger"`
+	OffsetMinutes int             `json:"offset_minutes"`
+	RepeatMinutes int             `json:"repeat_minutes"`
+	Channel       ReminderChannel `json:"channel"`
+	WebhookURL    *string         `json:"webhook_url"`
+	Enabled       bool            `json:"enabled"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+func (r *Reminder) Slot(scheduledTo time.Time, now time.Time) (time.Time, bool) {
+	switch r.Trigger {
+	case ReminderBeforeDue:
+		remindAt := scheduledTo.Add(-time.Duration(r.OffsetMinutes) * time.Minute)
+		if now.Before(remindAt) || !now.Before(scheduledTo) {
+			return time.Time{}, false
+		}
+		return remindAt, true
+	case ReminderOverdue:
+		if now.Before(scheduledTo) {
+			return time.Time{}, false
+		}
+		if r.RepeatMinutes <= 0 {
+			return scheduledTo, true
+		}
+		interval := time.Duration(r.RepeatMinutes) * time.Minute
+		periods := now.Sub(scheduledTo) / interval
+		return scheduledTo.Add(periods * interval), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+type ReminderCandidate struct {
+	Reminder    Reminder
+	TaskID      int64
+	TaskTitle   string
+	ScheduledTo time.Time
+	UserName    string
+	UserEmail   string
+}
+
+type ReminderRepository interface {
+	Create(ctx context.Context, reminder *Reminder) error
+	GetByID(ctx context.Context, id int64, tenantID int64) (*Reminder, error)
+	FetchByUser(ctx context.Context, userID int64, tenantID int64) ([]Reminder, error)
+	Update(ctx context.Context, reminder *Reminder) error
+	Delete(ctx context.Context, id int64, tenantID int64) error
+	FindCandidates(ctx context.Context, now time.Time) ([]ReminderCandidate, error)
+	RecordDelivery(ctx context.Context, reminderID int64, taskID int64, slot time.Time) (bool, error)
+	DeleteDelivery(ctx context.Context, reminderID int64, taskID int64, slot time.Time) error
+}
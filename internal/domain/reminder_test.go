@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReminder_Slot(t *testing.T) {
+	scheduled := time.Date(2026, time.October, 10, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		reminder   Reminder
+		now        time.Time
+		expected   time.Time
+		expectedOk bool
+	}{
+		{
+			name:       "antes da janela de antecedência",
+			reminder:   Reminder{Trigger: ReminderBeforeDue, OffsetMinutes: 60},
+			now:        scheduled.Add(-2 * time.Hour),
+			expectedOk: false,
+		},
+		{
+			name:       "dentro da janela de antecedência",
+			reminder:   Reminder{Trigger: ReminderBeforeDue, OffsetMinutes: 60},
+			now:        scheduled.Add(-30 * time.Minute),
+			expected:   scheduled.Add(-time.Hour),
+			expectedOk: true,
+		},
+		{
+			name:       "após o vencimento não lembra com antecedência",
+			reminder:   Reminder{Trigger: ReminderBeforeDue, OffsetMinutes: 60},
+			now:        scheduled.Add(time.Minute),
+			expectedOk: false,
+		},
+		{
+			name:       "atrasada sem repetição usa a data agendada",
+			reminder:   Reminder{Trigger: ReminderOverdue},
+			now:        scheduled.Add(50 * time.Hour),
+			expected:   scheduled,
+			expectedOk: true,
+		},
+		{
+			name:       "atrasada com repetição diária",
+			reminder:   Reminder{Trigger: ReminderOverdue, RepeatMinutes: 1440},
+			now:        scheduled.Add(50 * time.Hour),
+			expected:   scheduled.Add(48 * time.Hour),
+			expectedOk: true,
+		},
+		{
+			name:       "ainda não atrasada",
+			reminder:   Reminder{Trigger: ReminderOverdue, RepeatMinutes: 1440},
+			now:        scheduled.Add(-time.Minute),
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot, ok := tt.reminder.Slot(scheduled, tt.now)
+			if ok != tt.expectedOk {
+				t.Fatalf("ok esperado %v, obtido %v", tt.expectedOk, ok)
+			}
+			if ok && !slot.Equal(tt.expected) {
+				t.Errorf("slot esperado %v, obtido %v", tt.expected, slot)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type RedemptionStatus string
+
+const (
+	RedemptionPending  RedemptionStatus = "pending"
+	RedemptionApproved RedemptionStatus = "approved"
+	RedemptionRejected RedemptionStatus = "rejected"
+)
+
+func (s RedemptionStatus) IsValid() bool {
+	switch s {
+	case RedemptionPending, RedemptionApproved, RedemptionRejected:
+		return true
+	}
+	return false
+}
+
+type Reward struct {
+	ID          int64     `json:"id"`
+	TenantID    int64     `json:"tenant_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Cost        int       `json:"cost"`
+	Stock       *int      `json:"stock"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	CreatedById *int64    `json:"created_by_id"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	UpdatedById *int64    `json:"updated_by_id"`
+}
+
+func (r *Reward) IsAvailable() bool {
+	return r.Active && (r.Stock == nil || *r.Stock > 0)
+}
+
+type RewardRedemption struct {
+	ID          int64            `json:"id"`
+	TenantID    int64            `json:"tenant_id"`
+	RewardID    int64            `json:"reward_id"`
+	RewardTitle string           `json:"reward_title"`
+	UserID      int64            `json:"user_id"`
+	Cost        int              `json:"cost"`
+	Status      RedemptionStatus `json:"status"`
+	Note        *string          `json:"note"`
+	DecidedById *int64           `json:"decided_by_id"`
+	DecidedAt   *time.Time       `json:"decided_at"`
+	CreatedAt   time.Time        `json:"created_at"`
+}
+
+type RewardRepository interface {
+	Create(ctx context.Context, reward *Reward) error
+	GetByID(ctx context.Context, id int64, tenantID int64) (*Reward, error)
+	FetchAll(ctx context.Context, tenantID int64, activeOnly bool) ([]Reward, error)
+	Update(ctx context.Context, reward *Reward) error
+	Delete(ctx context.Context, id int64, tenantID int64) error
+	ReserveStock(ctx context.Context, id int64, tenantID int64) (bool, error)
+	ReleaseStock(ctx context.Context, id int64, tenantID int64) error
+}
+
+type RewardRedemptionRepository interface {
+	Create(ctx context.Context, redemption *RewardRedemption) error
+	GetByID(ctx context.Context, id int64, tenantID int64) (*RewardRedemption, error)
+	FetchAll(ctx context.Context, tenantID int64, userID *int64, status *RedemptionStatus) ([]RewardRedemption, error)
+	Decide(ctx context.Context, redemption *RewardRedemption) error
+}
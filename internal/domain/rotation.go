@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type RotationStrategy string
+
+const (
+	RotationRoundRobin             RotationStrategy = "round_robin"
+	RotationFewestPoints           RotationStrategy = "fewest_points"
+	RotationLeastRecentlyCompleted RotationStrategy = "least_recently_completed"
+)
+
+func (s RotationStrategy) IsValid() bool {
+	switch s {
+	case RotationRoundRobin, RotationFewestPoints, RotationLeastRecentlyCompleted:
+		return true
+	default:
+		return false
+	}
+}
+
+type TaskRotation struct {
+	ID             int64            `json:"id"`
+	TenantID       int64            `json:"tenant_id"`
+	Strategy       RotationStrategy `json:"strategy"`
+	MemberIDs      []int64          `json:"member_ids"`
+	LastAssigneeID *int64           `json:"last_assignee_id"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+}
+
+func (r *TaskRotation) HasMember(userID int64) bool {
+	for _, id := range r.MemberIDs {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+type TaskRotationRepository interface {
+	Create(ctx context.Context, rotation *TaskRotation) error
+	GetByID(ctx context.Context, id int64, tenantID int64) (*TaskRotation, error)
+	Update(ctx context.Context, rotation *TaskRotation) error
+	GetLastCompletions(ctx context.Context, rotationID int64, tenantID int64) (map[int64]time.Time, error)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type UserCompletionStats struct {
+	UserID          int64
+	Name            string
+	Status          string
+	Completed       int
+	TaskPoints      int
+	Effort          int
+	Scheduled       int
+	OnTime          int
+	AvgDelaySeconds *float64
+}
+
+func (s UserCompletionStats) Late() int {
+	return s.Scheduled - s.OnTime
+}
+
+type WeekdayCompletions struct {
+	Weekday   time.Weekday
+	Completed int
+}
+
+type StatsRepository interface {
+	FetchUserCompletions(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]UserCompletionStats, error)
+	FetchPointsEarned(ctx context.Context, tenantID int64, from time.Time, to time.Time) (map[int64]int, error)
+	FetchWeekdayCompletions(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]WeekdayCompletions, error)
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type StreakKind string
+
+const (
+	StreakUserDaily    StreakKind = "user_daily"
+	StreakSeriesOnTime StreakKind = "series_on_time"
+)
+
+type Streak struct {
+	TenantID  int64      `json:"tenant_id"`
+	Kind      StreakKind `json:"kind"`
+	SubjectID int64      `json:"subject_id"`
+	Current   int        `json:"current"`
+	Longest   int        `json:"longest"`
+	LastOn    *time.Time `json:"last_on"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func NewStreak(tenantID int64, kind StreakKind, subjectID int64) *Streak {
+	return &Streak{TenantID: tenantID, Kind: kind, SubjectID: subjectID}
+}
+
+func StreakDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *Streak) RecordDay(at time.Time) bool {
+	day := StreakDay(at)
+
+	if s.LastOn != nil {
+		last := StreakDay(*s.LastOn)
+		switch {
+		case day.Equal(last):
+			return true
+		case day.Before(last):
+			return false
+		case day.Equal(last.AddDate(0, 0, 1)):
+			s.Current++
+		default:
+			s.Current = 1
+		}
+	} else {
+		s.Current = 1
+	}
+
+	s.LastOn = &day
+	if s.Current > s.Longest {
+		s.Longest = s.Current
+	}
+	return true
+}
+
+func (s *Streak) RecordOccurrence(at time.Time, onTime bool) {
+	day := StreakDay(at)
+	s.LastOn = &day
+
+	if !onTime {
+		s.Current = 0
+		return
+	}
+
+	s.Current++
+	if s.Current > s.Longest {
+		s.Longest = s.Current
+	}
+}
+
+func (s *Streak) RebuildFromDays(days []time.Time) {
+	s.Current, s.Longest, s.LastOn = 0, 0, nil
+	for _, day := range days {
+		s.RecordDay(day)
+	}
+}
+
+func (s *Streak) RebuildFromOccurrences(occurrences []StreakOccurrence) {
+	s.Current, s.Longest, s.LastOn = 0, 0, nil
+	for _, occurrence := range occurrences {
+		s.RecordOccurrence(occurrence.CompletedAt, occurrence.OnTime)
+	}
+}
+
+func (s *Streak) CurrentAt(now time.Time) int {
+	if s.Kind != StreakUserDaily || s.LastOn == nil {
+		return s.Current
+	}
+
+	if StreakDay(*s.LastOn).Before(StreakDay(now).AddDate(0, 0, -1)) {
+		return 0
+	}
+	return s.Current
+}
+
+type StreakOccurrence struct {
+	TaskID      int64
+	ScheduledTo *time.Time
+	CompletedAt time.Time
+	OnTime      bool
+}
+
+type StreakRepository interface {
+	MarkEventProcessed(ctx context.Context, eventID int64) (bool, error)
+	Get(ctx context.Context, kind StreakKind, subjectID int64, tenantID int64) (*Streak, error)
+	Save(ctx context.Context, streak *Streak) error
+	AddActivity(ctx context.Context, tenantID int64, userID int64, day time.Time, delta int) (int, error)
+	FetchActivityDays(ctx context.Context, userID int64, tenantID int64) ([]time.Time, error)
+	RecordOccurrence(ctx context.Context, tenantID int64, seriesID int64, occurrence StreakOccurrence) error
+	RemoveOccurrence(ctx context.Context, taskID int64, tenantID int64) error
+	FetchOccurrences(ctx context.Context, seriesID int64, tenantID int64) ([]StreakOccurrence, error)
+}
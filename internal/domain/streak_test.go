@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func day(d int) time.Time {
+	return time.Date(2026, time.October, d, 20, 0, 0, 0, time.Local)
+}
+
+func TestStreak_RecordDay(t *testing.T) {
+	tests := []struct {
+		name            string
+		days            []time.Time
+		now             time.Time
+		expectedCurrent int
+		expectedLongest int
+	}{
+		{
+			name:            "dias consecutivos aumentam a sequência",
+			days:            []time.Time{day(1), day(2), day(3)},
+			now:             day(3),
+			expectedCurrent: 3,
+			expectedLongest: 3,
+		},
+		{
+			name:            "várias conclusões no mesmo dia contam uma vez",
+			days:            []time.Time{day(1), day(1), day(2)},
+			now:             day(2),
+			expectedCurrent: 2,
+			expectedLongest: 2,
+		},
+		{
+			name:            "lacuna reinicia a sequência e preserva a maior",
+			days:            []time.Time{day(1), day(2), day(3), day(5)},
+			now:             day(5),
+			expectedCurrent: 1,
+			expectedLongest: 3,
+		},
+		{
+			name:            "sequência de ontem ainda está ativa",
+			days:            []time.Time{day(1), day(2)},
+			now:             day(3),
+			expectedCurrent: 2,
+			expectedLongest: 2,
+		},
+		{
+			name:            "sequência expira após um dia sem conclusões",
+			days:            []time.Time{day(1), day(2)},
+			now:             day(4),
+			expectedCurrent: 0,
+			expectedLongest: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streak := NewStreak(1, StreakUserDaily, 7)
+			for _, d := range tt.days {
+				if !streak.RecordDay(d) {
+					t.Fatalf("dia %v deveria ser registrado em ordem", d)
+				}
+			}
+
+			if current := streak.CurrentAt(tt.now); current != tt.expectedCurrent {
+				t.Errorf("sequência atual esperada %d, obtida %d", tt.expectedCurrent, current)
+			}
+			if streak.Longest != tt.expectedLongest {
+				t.Errorf("maior sequência esperada %d, obtida %d", tt.expectedLongest, streak.Longest)
+			}
+		})
+	}
+}
+
+func TestStreak_RecordDayOutOfOrder(t *testing.T) {
+	streak := NewStreak(1, StreakUserDaily, 7)
+	streak.RecordDay(day(5))
+
+	if streak.RecordDay(day(3)) {
+		t.Fatal("dia anterior ao último registrado deveria exigir reconstrução")
+	}
+
+	streak.RebuildFromDays([]time.Time{day(3), day(4), day(5)})
+	if streak.Current != 3 || streak.Longest != 3 {
+		t.Errorf("reconstrução esperada 3/3, obtida %d/%d", streak.Current, streak.Longest)
+	}
+}
+
+func TestStreak_RecordOccurrence(t *testing.T) {
+	tests := []struct {
+		name            string
+		onTime          []bool
+		expectedCurrent int
+		expectedLongest int
+	}{
+		{
+			name:            "ocorrências no prazo aumentam a sequência",
+			onTime:          []bool{true, true, true},
+			expectedCurrent: 3,
+			expectedLongest: 3,
+		},
+		{
+			name:            "atraso zera a sequência atual",
+			onTime:          []bool{true, true, false},
+			expectedCurrent: 0,
+			expectedLongest: 2,
+		},
+		{
+			name:            "nova sequência após atraso",
+			onTime:          []bool{true, false, true},
+			expectedCurrent: 1,
+			expectedLongest: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrences := make([]StreakOccurrence, len(tt.onTime))
+			for i, onTime := range tt.onTime {
+				occurrences[i] = StreakOccurrence{TaskID: int64(i + 1), CompletedAt: day(i + 1), OnTime: onTime}
+			}
+
+			streak := NewStreak(1, StreakSeriesOnTime, 3)
+			streak.RebuildFromOccurrences(occurrences)
+
+			if current := streak.CurrentAt(day(28)); current != tt.expectedCurrent {
+				t.Errorf("sequência atual esperada %d, obtida %d", tt.expectedCurrent, current)
+			}
+			if streak.Longest != tt.expectedLongest {
+				t.Errorf("maior sequência esperada %d, obtida %d", tt.expectedLongest, streak.Longest)
+			}
+		})
+	}
+}
@@ -15,59 +15,82 @@ const (
 )
 
 type Task struct {
-	ID             int64          `json:"id"`
-	Title          string         `json:"title"`
-	Description    string         `json:"description"`
-	Points         int            `json:"points"`
-	Status         string         `json:"status"`
-	ScheduledTo    *time.Time     `json:"scheduled_to"`
-	ScheduledById  *int64         `json:"scheduled_by_id"`
-	FrequencyValue int            `json:"frequency_value"`
-	FrequencyUnit  FrequencyUnit  `json:"frequency_unit"`
-	Completed      bool           `json:"completed"`
-	CompletedById  *int64         `json:"completed_by_id"`
-	TenantID       int64          `json:"tenant_id"`
-	CreatedAt      time.Time      `json:"created_at"`
-	CreatedById    int64          `json:"created_by_id"`
-	UpdatedAt      time.Time      `json:"updated_at"`
-	UpdatedById    *int64         `json:"updated_by_id"`
-	DeletedAt      *time.Time     `json:"deleted_at"`
+	ID             int64            `json:"id"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	Points         int              `json:"points"`
+	Status         string           `json:"status"`
+	ScheduledTo    *time.Time       `json:"scheduled_to"`
+	ScheduledById  *int64           `json:"scheduled_by_id"`
+	FrequencyValue int              `json:"frequency_value"`
+	FrequencyUnit  FrequencyUnit    `json:"frequency_unit"`
+	Completed      bool             `json:"completed"`
+	CompletedById  *int64           `json:"completed_by_id"`
+	AssigneeID     *int64           `json:"assignee_id"`
+	RotationID     *int64           `json:"rotation_id"`
+	SeriesID       *int64           `json:"series_id"`
+	PreviousTaskID *int64           `json:"previous_task_id"`
+	RecurrenceRule *string          `json:"recurrence_rule"`
+	AnchorMode     RecurrenceAnchor `json:"anchor_mode"`
+	OverdueAt      *time.Time       `json:"overdue_at"`
+	CompletedAt    *time.Time       `json:"completed_at"`
+	TenantID       int64            `json:"tenant_id"`
+	CreatedAt      time.Time        `json:"created_at"`
+	CreatedById    int64            `json:"created_by_id"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	UpdatedById    *int64           `json:"updated_by_id"`
+	DeletedAt      *time.Time       `json:"deleted_at"`
+	Checklist      []ChecklistItem  `json:"checklist,omitempty"`
 }
 
+var ErrRecurrenceEnded = errors.New("recurrence has no further occurrences")
+
 func (t *Task) CalculateNextDueDate(completionDate time.Time) (time.Time, error) {
-	if t.FrequencyUnit == "" || t.FrequencyValue <= 0 {
-		return time.Time{}, errors.New("frequency unit or frequency value not defined")
+	next, ok, err := t.recurrence().next(t.ScheduledTo, completionDate)
+	if err != nil {
+		return time.Time{}, err
 	}
-
-	switch t.FrequencyUnit {
-	case UnitDays:
-		return completionDate.AddDate(0, 0, t.FrequencyValue), nil
-	case UnitWeeks:
-		return completionDate.AddDate(0, 0, t.FrequencyValue*7), nil
-	case UnitMonths:
-		return completionDate.AddDate(0, t.FrequencyValue, 0), nil
-	default:
-		return time.Time{}, errors.New("invalid frequency unit")
+	if !ok {
+		return time.Time{}, ErrRecurrenceEnded
 	}
+	return next, nil
 }
 
 func (t *Task) CalculatePreviousDueDate(nextDueDate time.Time) (time.Time, error) {
-	if t.FrequencyUnit == "" || t.FrequencyValue <= 0 {
-		return time.Time{}, errors.New("frequency unit or frequency value not defined")
+	return t.recurrence().previous(t.ScheduledTo, nextDueDate)
+}
+
+func (t *Task) recurrence() recurrence {
+	start := time.Time{}
+	if t.ScheduledTo != nil {
+		start = *t.ScheduledTo
 	}
 
-	switch t.FrequencyUnit {
-	case UnitDays:
-		return nextDueDate.AddDate(0, 0, -t.FrequencyValue), nil
-	case UnitWeeks:
-		return nextDueDate.AddDate(0, 0, -t.FrequencyValue*7), nil
-	case UnitMonths:
-		return nextDueDate.AddDate(0, -t.FrequencyValue, 0), nil
-	default:
-		return time.Time{}, errors.New("invalid frequency unit")
+	return recurrence{
+		frequencyValue: t.FrequencyValue,
+		frequencyUnit:  t.FrequencyUnit,
+		rule:           t.RecurrenceRule,
+		anchor:         t.AnchorMode,
+		start:          start,
 	}
 }
 
+func (t *Task) IsOverdue() bool {
+	return t.OverdueAt != nil && !t.Completed
+}
+
+func (t *Task) IsRecurring() bool {
+	return t.RecurrenceRule != nil || (t.FrequencyValue > 0 && t.FrequencyUnit != "")
+}
+
+func (t *Task) CompletedOnTime(completedAt time.Time) bool {
+	return t.OverdueAt == nil && (t.ScheduledTo == nil || !completedAt.After(*t.ScheduledTo))
+}
+
+func (t *Task) IsAssignedTo(userID int64) bool {
+	return t.AssigneeID != nil && *t.AssigneeID == userID
+}
+
 type TaskWithUser struct {
 	Task
 	CompletedByName *string `json:"completed_by_name"`
@@ -77,10 +100,17 @@ type TaskRepository interface {
 	Create(ctx context.Context, task *Task) error
 	FetchAll(ctx context.Context, tenantID int64) ([]Task, error)
 	GetByID(ctx context.Context, id int64, tenantID int64) (*Task, error)
+	GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*Task, error)
 	Update(ctx context.Context, task *Task) error
 	Delete(ctx context.Context, id int64, tenantID int64) error
 	GetUpcomingTasks(ctx context.Context, tenantID int64, limit int, offset int) ([]Task, error)
+	FetchAllByAssignee(ctx context.Context, tenantID int64, assigneeID int64) ([]Task, error)
+	GetUpcomingTasksByAssignee(ctx context.Context, tenantID int64, assigneeID int64, limit int, offset int) ([]Task, error)
 	GetCompletedTasksHistory(ctx context.Context, tenantID int64, limit int) ([]TaskWithUser, error)
 	GetCompletedTasksByUser(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]TaskWithUser, error)
-	FindTaskCreatedAfterCompletion(ctx context.Context, originalTask *Task, completionTime time.Time) (*Task, error)
-}
\ No newline at end of file
+	FindNextOccurrence(ctx context.Context, taskID int64, tenantID int64) (*Task, error)
+	GetPendingBySeries(ctx context.Context, seriesID int64, tenantID int64) ([]Task, error)
+	GetSeriesHistory(ctx context.Context, seriesID int64, tenantID int64, limit int, offset int) ([]TaskWithUser, error)
+	MarkOverdue(ctx context.Context, now time.Time) ([]Task, error)
+	UnassignPending(ctx context.Context, tenantID int64, assigneeID int64, from time.Time, to *time.Time, now time.Time) error
+}
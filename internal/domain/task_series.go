@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type TaskSeries struct {
+	ID             int64            `json:"id"`
+	TenantID       int64            `json:"tenant_id"`
+	Title          string           `json:"title"`
+	Description    string           `json:"description"`
+	Points         int              `json:"points"`
+	FrequencyValue int              `json:"frequency_value"`
+	FrequencyUnit  FrequencyUnit    `json:"frequency_unit"`
+	RecurrenceRule *string          `json:"recurrence_rule"`
+	StartsAt       time.Time        `json:"starts_at"`
+	AnchorMode     RecurrenceAnchor `json:"anchor_mode"`
+	PausedAt       *time.Time       `json:"paused_at"`
+	CreatedAt      time.Time        `json:"created_at"`
+	CreatedById    int64            `json:"created_by_id"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	UpdatedById    *int64           `json:"updated_by_id"`
+	DeletedAt      *time.Time       `json:"deleted_at"`
+}
+
+func (s *TaskSeries) IsPaused() bool {
+	return s.PausedAt != nil
+}
+
+func (s *TaskSeries) NextDueDate(scheduledTo *time.Time, completedAt time.Time) (time.Time, bool, error) {
+	r := recurrence{
+		frequencyValue: s.FrequencyValue,
+		frequencyUnit:  s.FrequencyUnit,
+		rule:           s.RecurrenceRule,
+		anchor:         s.AnchorMode,
+		start:          s.StartsAt,
+	}
+
+	return r.next(scheduledTo, completedAt)
+}
+
+func (s *TaskSeries) ApplyTo(task *Task) {
+	task.Title = s.Title
+	task.Description = s.Description
+	task.Points = s.Points
+	task.FrequencyValue = s.FrequencyValue
+	task.FrequencyUnit = s.FrequencyUnit
+	task.RecurrenceRule = s.RecurrenceRule
+	task.AnchorMode = s.AnchorMode
+}
+
+type TaskSeriesRepository interface {
+	Create(ctx context.Context, series *TaskSeries) error
+	FetchAll(ctx context.Context, tenantID int64) ([]TaskSeries, error)
+	GetByID(ctx context.Context, id int64, tenantID int64) (*TaskSeries, error)
+	Update(ctx context.Context, series *TaskSeries) error
+	Delete(ctx context.Context, id int64, tenantID int64) error
+}
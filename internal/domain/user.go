@@ -2,9 +2,16 @@ package domain
 
 import (
 	"context"
+	"strings"
 	"time"
 )
 
+const (
+	RoleSuperAdmin = "super_admin"
+	RoleAdmin      = "admin"
+	RoleUser       = "user"
+)
+
 type User struct {
 	ID         int64      `json:"id"`
 	Name       string     `json:"name"`
@@ -20,13 +27,22 @@ type User struct {
 	DeletedAt  *time.Time `json:"deleted_at"`
 }
 
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin || u.Role == RoleSuperAdmin
+}
+
 type UserRepository interface {
 	Create(ctx context.Context, user *User) error
-	GetByID(ctx context.Context, id int64) (*User, error)
+	GetByID(ctx context.Context, id int64, tenantID int64) (*User, error)
+	GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByEmailAndTenant(ctx context.Context, email string, tenantID int64) (*User, error)
 	FetchAll(ctx context.Context, tenantID int64) ([]User, error)
 	GetTopUsersByPoints(ctx context.Context, tenantID int64, limit int) ([]User, error)
 	Update(ctx context.Context, user *User) error
-	Delete(ctx context.Context, id int64) error
+	Delete(ctx context.Context, id int64, tenantID int64) error
 }
@@ -0,0 +1,37 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func EncodePayload(payload interface{}) ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+func DecodePayload(eventType EventType, data []byte) (interface{}, error) {
+	switch eventType {
+	case EventTypeTaskCompleted:
+		return decode[TaskCompletedPayload](data)
+	case EventTypeTaskUndone:
+		return decode[TaskUndonePayload](data)
+	case EventTypeComplimentReceived:
+		return decode[ComplimentReceivedPayload](data)
+	case EventTypeTaskOverdue:
+		return decode[TaskOverduePayload](data)
+	case EventTypeTaskAssigned:
+		return decode[TaskAssignedPayload](data)
+	case EventTypeAchievementUnlocked:
+		return decode[AchievementUnlockedPayload](data)
+	default:
+		return nil, fmt.Errorf("unknown event type %s", eventType)
+	}
+}
+
+func decode[T any](data []byte) (interface{}, error) {
+	var payload T
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
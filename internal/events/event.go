@@ -11,27 +11,72 @@ const (
 	EventTypeTaskCompleted     EventType = "task.completed"
 	EventTypeTaskUndone        EventType = "task.undone"
 	EventTypeComplimentReceived EventType = "compliment.received"
+	EventTypeTaskOverdue       EventType = "task.overdue"
+	EventTypeTaskAssigned      EventType = "task.assigned"
+	EventTypeAchievementUnlocked EventType = "achievement.unlocked"
 )
 
 type Event struct {
+	ID        int64
 	Type      EventType
 	Payload   interface{}
 	Timestamp time.Time
 }
 
 type TaskCompletedPayload struct {
+	TaskID      int64
+	TaskTitle   string
 	CompletedBy int64
+	TenantID    int64
 	Points      int
+	WasOverdue  bool
+	OnTime      bool
+	SeriesID    *int64
+	ScheduledTo *time.Time
 }
 
 type TaskUndonePayload struct {
+	TaskID      int64
+	TaskTitle   string
 	CompletedBy int64
+	TenantID    int64
 	Points      int
+	WasOverdue  bool
+	SeriesID    *int64
+	CompletedAt time.Time
 }
 
 type ComplimentReceivedPayload struct {
-	ToUser int64
-	Points int
+	ComplimentID int64
+	Title        string
+	FromUser     int64
+	ToUser       int64
+	TenantID     int64
+	Points       int
+}
+
+type TaskOverduePayload struct {
+	TaskID      int64
+	TaskTitle   string
+	TenantID    int64
+	AssigneeID  *int64
+	ScheduledTo time.Time
+}
+
+type TaskAssignedPayload struct {
+	TaskID     int64
+	TaskTitle  string
+	AssigneeID int64
+	AssignedBy int64
+	TenantID   int64
+}
+
+type AchievementUnlockedPayload struct {
+	AchievementID int64
+	Code          string
+	Name          string
+	UserID        int64
+	TenantID      int64
 }
 
 type EventHandler func(ctx context.Context, event Event) error
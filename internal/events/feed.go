@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"log"
+	"sync"
+)
+
+type Feed struct {
+	repo     domain.OutboxRepository
+	handlers map[EventType][]EventHandler
+	mu       sync.RWMutex
+}
+
+func NewFeed(repo domain.OutboxRepository) *Feed {
+	return &Feed{
+		repo:     repo,
+		handlers: make(map[EventType][]EventHandler),
+	}
+}
+
+func (f *Feed) Subscribe(eventType EventType, handler EventHandler) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[eventType] = append(f.handlers[eventType], handler)
+}
+
+func (f *Feed) Notify(ctx context.Context, id int64) {
+	outboxEvent, err := f.repo.GetByID(ctx, id)
+	if err != nil {
+		log.Printf("Error loading outbox event %d for feed: %v", id, err)
+		return
+	}
+	if outboxEvent == nil {
+		return
+	}
+
+	eventType := EventType(outboxEvent.EventType)
+
+	f.mu.RLock()
+	handlers := f.handlers[eventType]
+	f.mu.RUnlock()
+
+	if len(handlers) == 0 {
+		return
+	}
+
+	payload, err := DecodePayload(eventType, outboxEvent.Payload)
+	if err != nil {
+		log.Printf("Error decoding outbox event %d for feed: %v", id, err)
+		return
+	}
+
+	event := Event{
+		ID:        outboxEvent.ID,
+		Type:      eventType,
+		Payload:   payload,
+		Timestamp: outboxEvent.OccurredAt,
+	}
+
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			log.Printf("Error feeding outbox event %d (%s): %v", id, eventType, err)
+		}
+	}
+}
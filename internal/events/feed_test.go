@@ -0,0 +1,46 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"testing"
+)
+
+func TestFeed_NotifyDeliversToSubscribers(t *testing.T) {
+	payload, _ := EncodePayload(TaskCompletedPayload{TaskID: 1, CompletedBy: 2, TenantID: 3, Points: 10})
+	repo := &fakeOutboxRepository{pending: []domain.OutboxEvent{
+		{ID: 1, EventType: string(EventTypeTaskCompleted), Payload: payload},
+		{ID: 2, EventType: "unknown.event", Payload: []byte(`{}`)},
+	}}
+
+	feed := NewFeed(repo)
+
+	var received []Event
+	feed.Subscribe(EventTypeTaskCompleted, func(ctx context.Context, event Event) error {
+		received = append(received, event)
+		return errors.New("subscriber error")
+	})
+	secondCalls := 0
+	feed.Subscribe(EventTypeTaskCompleted, func(ctx context.Context, event Event) error {
+		secondCalls++
+		return nil
+	})
+
+	feed.Notify(context.Background(), 1)
+	feed.Notify(context.Background(), 2)
+	feed.Notify(context.Background(), 99)
+
+	if len(received) != 1 {
+		t.Fatalf("esperado 1 evento recebido, obtido %d", len(received))
+	}
+	if received[0].ID != 1 || received[0].Type != EventTypeTaskCompleted {
+		t.Errorf("evento inesperado: %+v", received[0])
+	}
+	if _, ok := received[0].Payload.(TaskCompletedPayload); !ok {
+		t.Errorf("payload esperado TaskCompletedPayload, obtido %T", received[0].Payload)
+	}
+	if secondCalls != 1 {
+		t.Errorf("esperado 1 chamada ao segundo assinante, obtido %d", secondCalls)
+	}
+}
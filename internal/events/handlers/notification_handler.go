@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
+	"time"
+)
+
+type NotificationHandler struct {
+	notificationRepo domain.NotificationRepository
+	userRepo         domain.UserRepository
+}
+
+func NewNotificationHandler(notificationRepo domain.NotificationRepository, userRepo domain.UserRepository) *NotificationHandler {
+	return &NotificationHandler{
+		notificationRepo: notificationRepo,
+		userRepo:         userRepo,
+	}
+}
+
+func (h *NotificationHandler) Handle(ctx context.Context, event events.Event) error {
+	switch event.Type {
+	case events.EventTypeTaskCompleted:
+		return h.handleTaskCompleted(ctx, event)
+	case events.EventTypeComplimentReceived:
+		return h.handleComplimentReceived(ctx, event)
+	case events.EventTypeTaskAssigned:
+		return h.handleTaskAssigned(ctx, event)
+	case events.EventTypeTaskOverdue:
+		return h.handleTaskOverdue(ctx, event)
+	case events.EventTypeAchievementUnlocked:
+		return h.handleAchievementUnlocked(ctx, event)
+	}
+
+	return nil
+}
+
+func (h *NotificationHandler) handleTaskCompleted(ctx context.Context, event events.Event) error {
+	payload, ok := event.Payload.(events.TaskCompletedPayload)
+	if !ok {
+		return nil
+	}
+
+	name, err := h.userName(ctx, payload.CompletedBy, payload.TenantID)
+	if err != nil {
+		return err
+	}
+
+	recipients, err := h.activeMembers(ctx, payload.TenantID, payload.CompletedBy)
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("%s completed \"%s\"", name, payload.TaskTitle)
+	if payload.Points > 0 {
+		body = fmt.Sprintf("%s and earned %d points", body, payload.Points)
+	}
+
+	taskID := payload.TaskID
+	for _, userID := range recipients {
+		if err := h.notify(ctx, event, userID, payload.TenantID, "Task completed", body, &taskID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *NotificationHandler) handleComplimentReceived(ctx context.Context, event events.Event) error {
+	payload, ok := event.Payload.(events.ComplimentReceivedPayload)
+	if !ok {
+		return nil
+	}
+
+	name, err := h.userName(ctx, payload.FromUser, payload.TenantID)
+	if err != nil {
+		return err
+	}
+
+	body := fmt.Sprintf("%s sent you a compliment: %s", name, payload.Title)
+	return h.notify(ctx, event, payload.ToUser, payload.TenantID, "New compliment", body, nil)
+}
+
+func (h *NotificationHandler) handleTaskAssigned(ctx context.Context, event events.Event) error {
+	payload, ok := event.Payload.(events.TaskAssignedPayload)
+	if !ok {
+		return nil
+	}
+
+	if payload.AssignedBy == payload.AssigneeID {
+		return nil
+	}
+
+	name, err := h.userName(ctx, payload.AssignedBy, payload.TenantID)
+	if err != nil {
+		return err
+	}
+
+	taskID := payload.TaskID
+	body := fmt.Sprintf("%s assigned \"%s\" to you", name, payload.TaskTitle)
+	return h.notify(ctx, event, payload.AssigneeID, payload.TenantID, "New task assigned", body, &taskID)
+}
+
+func (h *NotificationHandler) handleTaskOverdue(ctx context.Context, event events.Event) error {
+	payload, ok := event.Payload.(events.TaskOverduePayload)
+	if !ok {
+		return nil
+	}
+
+	recipients := []int64{}
+	if payload.AssigneeID != nil {
+		recipients = append(recipients, *payload.AssigneeID)
+	} else {
+		members, err := h.activeMembers(ctx, payload.TenantID, 0)
+		if err != nil {
+			return err
+		}
+		recipients = members
+	}
+
+	taskID := payload.TaskID
+	body := fmt.Sprintf("\"%s\" was due at %s and is still pending", payload.TaskTitle, payload.ScheduledTo.Format("2006-01-02 15:04"))
+	for _, userID := range recipients {
+		if err := h.notify(ctx, event, userID, payload.TenantID, "Task overdue", body, &taskID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *NotificationHandler) handleAchievementUnlocked(ctx context.Context, event events.Event) error {
+	payload, ok := event.Payload.(events.AchievementUnlockedPayload)
+	if !ok {
+		return nil
+	}
+
+	body := fmt.Sprintf("You unlocked \"%s\"", payload.Name)
+	return h.notify(ctx, event, payload.UserID, payload.TenantID, "Achievement unlocked", body, nil)
+}
+
+func (h *NotificationHandler) notify(ctx context.Context, event events.Event, userID int64, tenantID int64, title string, body string, taskID *int64) error {
+	createdAt := event.Timestamp
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	return h.notificationRepo.Create(ctx, &domain.Notification{
+		TenantID:  tenantID,
+		UserID:    userID,
+		Type:      string(event.Type),
+		Title:     title,
+		Body:      body,
+		TaskID:    taskID,
+		CreatedAt: createdAt,
+	})
+}
+
+func (h *NotificationHandler) activeMembers(ctx context.Context, tenantID int64, exceptUserID int64) ([]int64, error) {
+	users, err := h.userRepo.FetchAll(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for _, user := range users {
+		if user.ID == exceptUserID || user.Status != "active" || user.DeletedAt != nil {
+			continue
+		}
+		ids = append(ids, user.ID)
+	}
+
+	return ids, nil
+}
+
+func (h *NotificationHandler) userName(ctx context.Context, userID int64, tenantID int64) (string, error) {
+	user, err := h.userRepo.GetByID(ctx, userID, tenantID)
+	if err != nil {
+		return "", err
+	}
+
+	if user == nil {
+		return "Someone", nil
+	}
+
+	return user.Name, nil
+}
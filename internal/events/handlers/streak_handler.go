@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
+	"time"
+)
+
+type StreakHandler struct {
+	streakRepo domain.StreakRepository
+	transactor domain.Transactor
+}
+
+func NewStreakHandler(streakRepo domain.StreakRepository, transactor domain.Transactor) *StreakHandler {
+	return &StreakHandler{
+		streakRepo: streakRepo,
+		transactor: transactor,
+	}
+}
+
+func (h *StreakHandler) Handle(ctx context.Context, event events.Event) error {
+	switch payload := event.Payload.(type) {
+	case events.TaskCompletedPayload:
+		return h.once(ctx, event, func(ctx context.Context, at time.Time) error {
+			return h.handleTaskCompleted(ctx, payload, at)
+		})
+	case events.TaskUndonePayload:
+		return h.once(ctx, event, func(ctx context.Context, at time.Time) error {
+			return h.handleTaskUndone(ctx, payload, at)
+		})
+	}
+
+	return nil
+}
+
+func (h *StreakHandler) once(ctx context.Context, event events.Event, fn func(ctx context.Context, at time.Time) error) error {
+	at := event.Timestamp
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	return h.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if event.ID != 0 {
+			fresh, err := h.streakRepo.MarkEventProcessed(ctx, event.ID)
+			if err != nil {
+				return err
+			}
+			if !fresh {
+				return nil
+			}
+		}
+
+		return fn(ctx, at)
+	})
+}
+
+func (h *StreakHandler) handleTaskCompleted(ctx context.Context, payload events.TaskCompletedPayload, at time.Time) error {
+	day := domain.StreakDay(at)
+	if _, err := h.streakRepo.AddActivity(ctx, payload.TenantID, payload.CompletedBy, day, 1); err != nil {
+		return err
+	}
+
+	streak, err := h.streakRepo.Get(ctx, domain.StreakUserDaily, payload.CompletedBy, payload.TenantID)
+	if err != nil {
+		return err
+	}
+
+	if streak == nil || !streak.RecordDay(day) {
+		if streak, err = h.rebuildUserStreak(ctx, payload.TenantID, payload.CompletedBy); err != nil {
+			return err
+		}
+	}
+
+	if err := h.save(ctx, streak, at); err != nil {
+		return err
+	}
+
+	if payload.SeriesID == nil {
+		return nil
+	}
+
+	occurrence := domain.StreakOccurrence{
+		TaskID:      payload.TaskID,
+		ScheduledTo: payload.ScheduledTo,
+		CompletedAt: at,
+		OnTime:      payload.OnTime,
+	}
+	if err := h.streakRepo.RecordOccurrence(ctx, payload.TenantID, *payload.SeriesID, occurrence); err != nil {
+		return err
+	}
+
+	series, err := h.streakRepo.Get(ctx, domain.StreakSeriesOnTime, *payload.SeriesID, payload.TenantID)
+	if err != nil {
+		return err
+	}
+
+	if series == nil {
+		if series, err = h.rebuildSeriesStreak(ctx, payload.TenantID, *payload.SeriesID); err != nil {
+			return err
+		}
+	} else {
+		series.RecordOccurrence(at, payload.OnTime)
+	}
+
+	return h.save(ctx, series, at)
+}
+
+func (h *StreakHandler) handleTaskUndone(ctx context.Context, payload events.TaskUndonePayload, at time.Time) error {
+	completedAt := payload.CompletedAt
+	if completedAt.IsZero() {
+		completedAt = at
+	}
+
+	remaining, err := h.streakRepo.AddActivity(ctx, payload.TenantID, payload.CompletedBy, domain.StreakDay(completedAt), -1)
+	if err != nil {
+		return err
+	}
+
+	if remaining == 0 {
+		streak, err := h.rebuildUserStreak(ctx, payload.TenantID, payload.CompletedBy)
+		if err != nil {
+			return err
+		}
+		if err := h.save(ctx, streak, at); err != nil {
+			return err
+		}
+	}
+
+	if payload.SeriesID == nil {
+		return nil
+	}
+
+	if err := h.streakRepo.RemoveOccurrence(ctx, payload.TaskID, payload.TenantID); err != nil {
+		return err
+	}
+
+	series, err := h.rebuildSeriesStreak(ctx, payload.TenantID, *payload.SeriesID)
+	if err != nil {
+		return err
+	}
+
+	return h.save(ctx, series, at)
+}
+
+func (h *StreakHandler) rebuildUserStreak(ctx context.Context, tenantID int64, userID int64) (*domain.Streak, error) {
+	days, err := h.streakRepo.FetchActivityDays(ctx, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	streak := domain.NewStreak(tenantID, domain.StreakUserDaily, userID)
+	streak.RebuildFromDays(days)
+	return streak, nil
+}
+
+func (h *StreakHandler) rebuildSeriesStreak(ctx context.Context, tenantID int64, seriesID int64) (*domain.Streak, error) {
+	occurrences, err := h.streakRepo.FetchOccurrences(ctx, seriesID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	streak := domain.NewStreak(tenantID, domain.StreakSeriesOnTime, seriesID)
+	streak.RebuildFromOccurrences(occurrences)
+	return streak, nil
+}
+
+func (h *StreakHandler) save(ctx context.Context, streak *domain.Streak, at time.Time) error {
+	streak.UpdatedAt = at
+	return h.streakRepo.Save(ctx, streak)
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
+	"sort"
+	"testing"
+	"time"
+)
+
+type fakeStreakRepo struct {
+	processed   map[int64]bool
+	streaks     map[domain.StreakKind]map[int64]domain.Streak
+	days        map[time.Time]int
+	occurrences map[int64]domain.StreakOccurrence
+}
+
+func newFakeStreakRepo() *fakeStreakRepo {
+	return &fakeStreakRepo{
+		processed:   make(map[int64]bool),
+		streaks:     map[domain.StreakKind]map[int64]domain.Streak{domain.StreakUserDaily: {}, domain.StreakSeriesOnTime: {}},
+		days:        make(map[time.Time]int),
+		occurrences: make(map[int64]domain.StreakOccurrence),
+	}
+}
+
+func (r *fakeStreakRepo) MarkEventProcessed(ctx context.Context, eventID int64) (bool, error) {
+	if r.processed[eventID] {
+		return false, nil
+	}
+	r.processed[eventID] = true
+	return true, nil
+}
+
+func (r *fakeStreakRepo) Get(ctx context.Context, kind domain.StreakKind, subjectID int64, tenantID int64) (*domain.Streak, error) {
+	streak, ok := r.streaks[kind][subjectID]
+	if !ok {
+		return nil, nil
+	}
+	return &streak, nil
+}
+
+func (r *fakeStreakRepo) Save(ctx context.Context, streak *domain.Streak) error {
+	r.streaks[streak.Kind][streak.SubjectID] = *streak
+	return nil
+}
+
+func (r *fakeStreakRepo) AddActivity(ctx context.Context, tenantID int64, userID int64, day time.Time, delta int) (int, error) {
+	r.days[day] += delta
+	if r.days[day] < 0 {
+		r.days[day] = 0
+	}
+	return r.days[day], nil
+}
+
+func (r *fakeStreakRepo) FetchActivityDays(ctx context.Context, userID int64, tenantID int64) ([]time.Time, error) {
+	var days []time.Time
+	for day, completions := range r.days {
+		if completions > 0 {
+			days = append(days, day)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days, nil
+}
+
+func (r *fakeStreakRepo) RecordOccurrence(ctx context.Context, tenantID int64, seriesID int64, occurrence domain.StreakOccurrence) error {
+	r.occurrences[occurrence.TaskID] = occurrence
+	return nil
+}
+
+func (r *fakeStreakRepo) RemoveOccurrence(ctx context.Context, taskID int64, tenantID int64) error {
+	delete(r.occurrences, taskID)
+	return nil
+}
+
+func (r *fakeStreakRepo) FetchOccurrences(ctx context.Context, seriesID int64, tenantID int64) ([]domain.StreakOccurrence, error) {
+	var occurrences []domain.StreakOccurrence
+	for _, occurrence := range r.occurrences {
+		occurrences = append(occurrences, occurrence)
+	}
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].TaskID < occurrences[j].TaskID })
+	return occurrences, nil
+}
+
+type passthroughTransactor struct{}
+
+func (passthroughTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestStreakHandler_Handle(t *testing.T) {
+	seriesID := int64(3)
+	at := func(d int) time.Time {
+		return time.Date(2026, time.October, d, 9, 0, 0, 0, time.Local)
+	}
+	completed := func(id int64, taskID int64, d int, onTime bool) events.Event {
+		return events.Event{ID: id, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: taskID, CompletedBy: 7, TenantID: 1, OnTime: onTime, SeriesID: &seriesID}, Timestamp: at(d)}
+	}
+	undone := func(id int64, taskID int64, completedDay int, d int) events.Event {
+		return events.Event{ID: id, Type: events.EventTypeTaskUndone, Payload: events.TaskUndonePayload{TaskID: taskID, CompletedBy: 7, TenantID: 1, SeriesID: &seriesID, CompletedAt: at(completedDay)}, Timestamp: at(d)}
+	}
+
+	tests := []struct {
+		name                  string
+		events                []events.Event
+		expectedUserCurrent   int
+		expectedUserLongest   int
+		expectedSeriesCurrent int
+		expectedSeriesLongest int
+	}{
+		{
+			name:                  "conclusões diárias no prazo",
+			events:                []events.Event{completed(1, 10, 1, true), completed(2, 11, 2, true), completed(3, 12, 3, true)},
+			expectedUserCurrent:   3,
+			expectedUserLongest:   3,
+			expectedSeriesCurrent: 3,
+			expectedSeriesLongest: 3,
+		},
+		{
+			name:                  "conclusão atrasada zera apenas a sequência da série",
+			events:                []events.Event{completed(1, 10, 1, true), completed(2, 11, 2, false)},
+			expectedUserCurrent:   2,
+			expectedUserLongest:   2,
+			expectedSeriesCurrent: 0,
+			expectedSeriesLongest: 1,
+		},
+		{
+			name:                  "desfazer remove o dia e recalcula as sequências",
+			events:                []events.Event{completed(1, 10, 1, true), completed(2, 11, 2, true), completed(3, 12, 3, true), undone(4, 12, 3, 4)},
+			expectedUserCurrent:   2,
+			expectedUserLongest:   2,
+			expectedSeriesCurrent: 2,
+			expectedSeriesLongest: 2,
+		},
+		{
+			name:                  "desfazer no meio quebra a sequência do usuário",
+			events:                []events.Event{completed(1, 10, 1, true), completed(2, 11, 2, true), completed(3, 12, 3, true), undone(4, 11, 2, 3)},
+			expectedUserCurrent:   1,
+			expectedUserLongest:   1,
+			expectedSeriesCurrent: 2,
+			expectedSeriesLongest: 2,
+		},
+		{
+			name:                  "reentrega do mesmo evento é ignorada",
+			events:                []events.Event{completed(1, 10, 1, true), completed(1, 10, 1, true), undone(2, 10, 1, 1), undone(2, 10, 1, 1)},
+			expectedUserCurrent:   0,
+			expectedUserLongest:   0,
+			expectedSeriesCurrent: 0,
+			expectedSeriesLongest: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeStreakRepo()
+			handler := NewStreakHandler(repo, passthroughTransactor{})
+
+			for _, event := range tt.events {
+				if err := handler.Handle(context.Background(), event); err != nil {
+					t.Fatalf("erro inesperado: %v", err)
+				}
+			}
+
+			user, _ := repo.Get(context.Background(), domain.StreakUserDaily, 7, 1)
+			if user == nil {
+				t.Fatal("sequência do usuário deveria ser salva")
+			}
+			if user.Current != tt.expectedUserCurrent || user.Longest != tt.expectedUserLongest {
+				t.Errorf("sequência do usuário esperada %d/%d, obtida %d/%d", tt.expectedUserCurrent, tt.expectedUserLongest, user.Current, user.Longest)
+			}
+
+			series, _ := repo.Get(context.Background(), domain.StreakSeriesOnTime, seriesID, 1)
+			if series == nil {
+				t.Fatal("sequência da série deveria ser salva")
+			}
+			if series.Current != tt.expectedSeriesCurrent || series.Longest != tt.expectedSeriesLongest {
+				t.Errorf("sequência da série esperada %d/%d, obtida %d/%d", tt.expectedSeriesCurrent, tt.expectedSeriesLongest, series.Current, series.Longest)
+			}
+		})
+	}
+}
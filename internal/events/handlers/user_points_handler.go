@@ -2,112 +2,64 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"keep-your-house-clean/internal/domain"
 	"keep-your-house-clean/internal/events"
 	"time"
 )
 
 type UserPointsHandler struct {
-	userRepo domain.UserRepository
+	ledgerRepo domain.PointsLedgerRepository
 }
 
-func NewUserPointsHandler(userRepo domain.UserRepository) *UserPointsHandler {
+func NewUserPointsHandler(ledgerRepo domain.PointsLedgerRepository) *UserPointsHandler {
 	return &UserPointsHandler{
-		userRepo: userRepo,
+		ledgerRepo: ledgerRepo,
 	}
 }
 
 func (h *UserPointsHandler) Handle(ctx context.Context, event events.Event) error {
-	if event.Type == events.EventTypeTaskCompleted {
-		return h.handleTaskCompleted(ctx, event)
+	switch payload := event.Payload.(type) {
+	case events.TaskCompletedPayload:
+		return h.post(ctx, event, event.Timestamp, payload.TenantID, payload.CompletedBy, payload.Points, domain.PointsReasonTaskCompleted, domain.PointsSourceTask, payload.TaskID)
+	case events.TaskUndonePayload:
+		createdAt := payload.CompletedAt
+		if createdAt.IsZero() {
+			createdAt = event.Timestamp
+		}
+		return h.post(ctx, event, createdAt, payload.TenantID, payload.CompletedBy, -payload.Points, domain.PointsReasonTaskUndone, domain.PointsSourceTask, payload.TaskID)
+	case events.ComplimentReceivedPayload:
+		return h.post(ctx, event, event.Timestamp, payload.TenantID, payload.ToUser, payload.Points, domain.PointsReasonComplimentReceived, domain.PointsSourceCompliment, payload.ComplimentID)
 	}
-	
-	if event.Type == events.EventTypeTaskUndone {
-		return h.handleTaskUndone(ctx, event)
-	}
-	
-	if event.Type == events.EventTypeComplimentReceived {
-		return h.handleComplimentReceived(ctx, event)
-	}
-	
+
 	return nil
 }
 
-func (h *UserPointsHandler) handleTaskCompleted(ctx context.Context, event events.Event) error {
-	payload, ok := event.Payload.(events.TaskCompletedPayload)
-	if !ok {
-		return nil
-	}
-
-	if payload.Points <= 0 {
+func (h *UserPointsHandler) post(ctx context.Context, event events.Event, createdAt time.Time, tenantID int64, userID int64, delta int, reason domain.PointsReason, sourceType domain.PointsSource, sourceID int64) error {
+	if delta == 0 {
 		return nil
 	}
 
-	user, err := h.userRepo.GetByID(ctx, payload.CompletedBy)
-	if err != nil {
-		return err
+	if createdAt.IsZero() {
+		createdAt = time.Now()
 	}
 
-	if user == nil {
-		return nil
-	}
-
-	user.Points += payload.Points
-	user.UpdatedAt = time.Now()
-
-	return h.userRepo.Update(ctx, user)
+	_, err := h.ledgerRepo.Post(ctx, &domain.PointsEntry{
+		TenantID:       tenantID,
+		UserID:         userID,
+		Delta:          delta,
+		Reason:         reason,
+		SourceType:     sourceType,
+		SourceID:       sourceID,
+		IdempotencyKey: idempotencyKey(event, sourceID),
+		CreatedAt:      createdAt,
+	})
+	return err
 }
 
-func (h *UserPointsHandler) handleTaskUndone(ctx context.Context, event events.Event) error {
-	payload, ok := event.Payload.(events.TaskUndonePayload)
-	if !ok {
-		return nil
-	}
-
-	if payload.Points <= 0 {
-		return nil
-	}
-
-	user, err := h.userRepo.GetByID(ctx, payload.CompletedBy)
-	if err != nil {
-		return err
-	}
-
-	if user == nil {
-		return nil
+func idempotencyKey(event events.Event, sourceID int64) string {
+	if event.ID != 0 {
+		return fmt.Sprintf("%s:event:%d", event.Type, event.ID)
 	}
-
-	user.Points -= payload.Points
-	if user.Points < 0 {
-		user.Points = 0
-	}
-	user.UpdatedAt = time.Now()
-
-	return h.userRepo.Update(ctx, user)
-}
-
-func (h *UserPointsHandler) handleComplimentReceived(ctx context.Context, event events.Event) error {
-	payload, ok := event.Payload.(events.ComplimentReceivedPayload)
-	if !ok {
-		return nil
-	}
-
-	if payload.Points <= 0 {
-		return nil
-	}
-
-	user, err := h.userRepo.GetByID(ctx, payload.ToUser)
-	if err != nil {
-		return err
-	}
-
-	if user == nil {
-		return nil
-	}
-
-	user.Points += payload.Points
-	user.UpdatedAt = time.Now()
-
-	return h.userRepo.Update(ctx, user)
+	return fmt.Sprintf("%s:%d:%d", event.Type, sourceID, event.Timestamp.UnixNano())
 }
-
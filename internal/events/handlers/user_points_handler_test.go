@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
+	"testing"
+	"time"
+)
+
+type fakeLedger struct {
+	entries []domain.PointsEntry
+	keys    map[string]bool
+}
+
+func (l *fakeLedger) Post(ctx context.Context, entry *domain.PointsEntry) (bool, error) {
+	if l.keys == nil {
+		l.keys = make(map[string]bool)
+	}
+	if l.keys[entry.IdempotencyKey] {
+		return false, nil
+	}
+	l.keys[entry.IdempotencyKey] = true
+	l.entries = append(l.entries, *entry)
+	return true, nil
+}
+
+func (l *fakeLedger) Spend(ctx context.Context, entry *domain.PointsEntry) (bool, error) {
+	return l.Post(ctx, entry)
+}
+
+func (l *fakeLedger) FetchByUser(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.PointsEntry, error) {
+	return l.entries, nil
+}
+
+func (l *fakeLedger) GetBalance(ctx context.Context, userID int64, tenantID int64) (int, error) {
+	balance := 0
+	for _, entry := range l.entries {
+		if entry.UserID == userID && entry.TenantID == tenantID {
+			balance += entry.Delta
+		}
+	}
+	return balance, nil
+}
+
+func (l *fakeLedger) SumByUser(ctx context.Context, tenantID int64, from time.Time, to time.Time, reasons []domain.PointsReason) (map[int64]int, error) {
+	return nil, nil
+}
+
+func TestUserPointsHandler_Handle(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name            string
+		events          []events.Event
+		expectedEntries int
+		expectedBalance int
+		expectedReason  domain.PointsReason
+	}{
+		{
+			name: "lança crédito ao completar tarefa",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 10, CompletedBy: 1, TenantID: 1, Points: 15}, Timestamp: now},
+			},
+			expectedEntries: 1,
+			expectedBalance: 15,
+			expectedReason:  domain.PointsReasonTaskCompleted,
+		},
+		{
+			name: "estorna sem limitar o saldo em zero ao desfazer",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskUndone, Payload: events.TaskUndonePayload{TaskID: 10, CompletedBy: 1, TenantID: 1, Points: 15}, Timestamp: now},
+			},
+			expectedEntries: 1,
+			expectedBalance: -15,
+			expectedReason:  domain.PointsReasonTaskUndone,
+		},
+		{
+			name: "lança crédito ao receber elogio",
+			events: []events.Event{
+				{ID: 2, Type: events.EventTypeComplimentReceived, Payload: events.ComplimentReceivedPayload{ComplimentID: 3, FromUser: 2, ToUser: 1, TenantID: 1, Points: 5}, Timestamp: now},
+			},
+			expectedEntries: 1,
+			expectedBalance: 5,
+			expectedReason:  domain.PointsReasonComplimentReceived,
+		},
+		{
+			name: "ignora reentrega do mesmo evento",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 10, CompletedBy: 1, TenantID: 1, Points: 15}, Timestamp: now},
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 10, CompletedBy: 1, TenantID: 1, Points: 15}, Timestamp: now},
+			},
+			expectedEntries: 1,
+			expectedBalance: 15,
+			expectedReason:  domain.PointsReasonTaskCompleted,
+		},
+		{
+			name: "registra conclusões repetidas da mesma tarefa como lançamentos distintos",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 10, CompletedBy: 1, TenantID: 1, Points: 15}, Timestamp: now},
+				{ID: 2, Type: events.EventTypeTaskUndone, Payload: events.TaskUndonePayload{TaskID: 10, CompletedBy: 1, TenantID: 1, Points: 15}, Timestamp: now},
+				{ID: 3, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 10, CompletedBy: 1, TenantID: 1, Points: 15}, Timestamp: now},
+			},
+			expectedEntries: 3,
+			expectedBalance: 15,
+			expectedReason:  domain.PointsReasonTaskCompleted,
+		},
+		{
+			name: "não lança nada para tarefas sem pontos",
+			events: []events.Event{
+				{ID: 1, Type: events.EventTypeTaskCompleted, Payload: events.TaskCompletedPayload{TaskID: 10, CompletedBy: 1, TenantID: 1}, Timestamp: now},
+			},
+			expectedEntries: 0,
+			expectedBalance: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger := &fakeLedger{}
+			handler := NewUserPointsHandler(ledger)
+
+			for _, event := range tt.events {
+				if err := handler.Handle(context.Background(), event); err != nil {
+					t.Fatalf("erro inesperado: %v", err)
+				}
+			}
+
+			if len(ledger.entries) != tt.expectedEntries {
+				t.Fatalf("esperados %d lançamentos, obtidos %d", tt.expectedEntries, len(ledger.entries))
+			}
+
+			balance, _ := ledger.GetBalance(context.Background(), 1, 1)
+			if balance != tt.expectedBalance {
+				t.Errorf("saldo esperado %d, obtido %d", tt.expectedBalance, balance)
+			}
+
+			if tt.expectedEntries > 0 {
+				last := ledger.entries[len(ledger.entries)-1]
+				if last.Reason != tt.expectedReason {
+					t.Errorf("motivo esperado %s, obtido %s", tt.expectedReason, last.Reason)
+				}
+			}
+		})
+	}
+}
+
+func TestUserPointsHandler_UndoneDatedAtOriginalCompletion(t *testing.T) {
+	completedAt := time.Date(2024, 3, 4, 18, 0, 0, 0, time.UTC)
+	undoneAt := time.Date(2024, 3, 12, 9, 0, 0, 0, time.UTC)
+
+	ledger := &fakeLedger{}
+	handler := NewUserPointsHandler(ledger)
+
+	event := events.Event{ID: 2, Type: events.EventTypeTaskUndone, Payload: events.TaskUndonePayload{TaskID: 10, CompletedBy: 1, TenantID: 1, Points: 15, CompletedAt: completedAt}, Timestamp: undoneAt}
+	if err := handler.Handle(context.Background(), event); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(ledger.entries) != 1 {
+		t.Fatalf("esperado 1 lançamento, obtidos %d", len(ledger.entries))
+	}
+	if !ledger.entries[0].CreatedAt.Equal(completedAt) {
+		t.Errorf("data do estorno esperada %v, obtida %v", completedAt, ledger.entries[0].CreatedAt)
+	}
+}
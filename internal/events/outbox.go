@@ -0,0 +1,41 @@
+package events
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+type OutboxPublisher struct {
+	repo domain.OutboxRepository
+}
+
+func NewOutboxPublisher(repo domain.OutboxRepository) *OutboxPublisher {
+	return &OutboxPublisher{repo: repo}
+}
+
+func (p *OutboxPublisher) Publish(ctx context.Context, event Event) error {
+	payload, err := EncodePayload(event.Payload)
+	if err != nil {
+		return err
+	}
+
+	occurredAt := event.Timestamp
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	now := time.Now()
+	return p.repo.Create(ctx, &domain.OutboxEvent{
+		EventType:   string(event.Type),
+		Payload:     payload,
+		Status:      domain.OutboxPending,
+		OccurredAt:  occurredAt,
+		AvailableAt: now,
+		CreatedAt:   now,
+	})
+}
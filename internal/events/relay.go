@@ -0,0 +1,152 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"keep-your-house-clean/internal/domain"
+	"log"
+	"sync"
+	"time"
+)
+
+type RelayConfig struct {
+	BatchSize   int
+	MaxAttempts int
+	Lease       time.Duration
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+type relayHandler struct {
+	name   string
+	handle EventHandler
+}
+
+type Relay struct {
+	repo       domain.OutboxRepository
+	transactor domain.Transactor
+	config     RelayConfig
+	handlers   map[EventType][]relayHandler
+	mu         sync.RWMutex
+}
+
+func NewRelay(repo domain.OutboxRepository, transactor domain.Transactor, config RelayConfig) *Relay {
+	return &Relay{
+		repo:       repo,
+		transactor: transactor,
+		config:     config,
+		handlers:   make(map[EventType][]relayHandler),
+	}
+}
+
+func (r *Relay) RegisterHandler(eventType EventType, name string, handler EventHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[eventType] = append(r.handlers[eventType], relayHandler{name: name, handle: handler})
+}
+
+func (r *Relay) Run(ctx context.Context) error {
+	for {
+		now := time.Now()
+		pending, err := r.repo.ClaimPending(ctx, now, now.Add(r.config.Lease), r.config.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, outboxEvent := range pending {
+			if err := r.process(ctx, outboxEvent); err != nil {
+				return err
+			}
+		}
+
+		if len(pending) < r.config.BatchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (r *Relay) process(ctx context.Context, outboxEvent domain.OutboxEvent) error {
+	eventType := EventType(outboxEvent.EventType)
+
+	payload, err := DecodePayload(eventType, outboxEvent.Payload)
+	if err != nil {
+		log.Printf("Moving outbox event %d to dead letter: %v", outboxEvent.ID, err)
+		return r.repo.MarkFailed(ctx, outboxEvent.ID, outboxEvent.Attempts+1, err.Error(), time.Now(), true)
+	}
+
+	event := Event{
+		ID:        outboxEvent.ID,
+		Type:      eventType,
+		Payload:   payload,
+		Timestamp: outboxEvent.OccurredAt,
+	}
+
+	if err := r.deliver(ctx, event); err != nil {
+		attempts := outboxEvent.Attempts + 1
+		dead := attempts >= r.config.MaxAttempts
+		if dead {
+			log.Printf("Moving outbox event %d (%s) to dead letter after %d attempts: %v", outboxEvent.ID, eventType, attempts, err)
+		} else {
+			log.Printf("Error delivering outbox event %d (%s), attempt %d: %v", outboxEvent.ID, eventType, attempts, err)
+		}
+		return r.repo.MarkFailed(ctx, outboxEvent.ID, attempts, err.Error(), time.Now().Add(r.backoff(attempts)), dead)
+	}
+
+	return r.repo.MarkDelivered(ctx, outboxEvent.ID, time.Now())
+}
+
+func (r *Relay) deliver(ctx context.Context, event Event) error {
+	r.mu.RLock()
+	handlers := r.handlers[event.Type]
+	r.mu.RUnlock()
+
+	delivered, err := r.repo.DeliveredHandlers(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+
+	done := make(map[string]bool, len(delivered))
+	for _, name := range delivered {
+		done[name] = true
+	}
+
+	var errs []error
+	for _, handler := range handlers {
+		if done[handler.name] {
+			continue
+		}
+
+		err := r.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := r.safeHandle(ctx, handler.handle, event); err != nil {
+				return fmt.Errorf("%s: %w", handler.name, err)
+			}
+			return r.repo.MarkHandlerDelivered(ctx, event.ID, handler.name, time.Now())
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *Relay) safeHandle(ctx context.Context, handler EventHandler, event Event) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("handler panicked: %v", p)
+		}
+	}()
+	return handler(ctx, event)
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	backoff := r.config.BaseBackoff
+	for i := 1; i < attempts && backoff < r.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.config.MaxBackoff {
+		backoff = r.config.MaxBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,313 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"testing"
+	"time"
+)
+
+type failure struct {
+	attempts    int
+	lastError   string
+	availableAt time.Time
+	dead        bool
+}
+
+type fakeOutboxRepository struct {
+	pending           []domain.OutboxEvent
+	delivered         []int64
+	failed            map[int64]failure
+	handlerDeliveries map[int64][]string
+}
+
+func (r *fakeOutboxRepository) Create(ctx context.Context, event *domain.OutboxEvent) error {
+	event.ID = int64(len(r.pending) + 1)
+	r.pending = append(r.pending, *event)
+	return nil
+}
+
+func (r *fakeOutboxRepository) GetByID(ctx context.Context, id int64) (*domain.OutboxEvent, error) {
+	for _, event := range r.pending {
+		if event.ID == id {
+			return &event, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeOutboxRepository) ClaimPending(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]domain.OutboxEvent, error) {
+	if len(r.pending) > limit {
+		claimed := r.pending[:limit]
+		r.pending = r.pending[limit:]
+		return claimed, nil
+	}
+	claimed := r.pending
+	r.pending = nil
+	return claimed, nil
+}
+
+func (r *fakeOutboxRepository) MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error {
+	r.delivered = append(r.delivered, id)
+	return nil
+}
+
+func (r *fakeOutboxRepository) MarkFailed(ctx context.Context, id int64, attempts int, lastError string, availableAt time.Time, dead bool) error {
+	if r.failed == nil {
+		r.failed = make(map[int64]failure)
+	}
+	r.failed[id] = failure{attempts: attempts, lastError: lastError, availableAt: availableAt, dead: dead}
+	return nil
+}
+
+func (r *fakeOutboxRepository) DeliveredHandlers(ctx context.Context, id int64) ([]string, error) {
+	return r.handlerDeliveries[id], nil
+}
+
+func (r *fakeOutboxRepository) MarkHandlerDelivered(ctx context.Context, id int64, handler string, deliveredAt time.Time) error {
+	if r.handlerDeliveries == nil {
+		r.handlerDeliveries = make(map[int64][]string)
+	}
+	r.handlerDeliveries[id] = append(r.handlerDeliveries[id], handler)
+	return nil
+}
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func testRelayConfig() RelayConfig {
+	return RelayConfig{
+		BatchSize:   10,
+		MaxAttempts: 3,
+		Lease:       time.Minute,
+		BaseBackoff: time.Second,
+		MaxBackoff:  time.Minute,
+	}
+}
+
+func TestOutboxPublisher_Publish(t *testing.T) {
+	repo := &fakeOutboxRepository{}
+	publisher := NewOutboxPublisher(repo)
+
+	payload := TaskCompletedPayload{TaskID: 1, TaskTitle: "Lavar louça", CompletedBy: 2, TenantID: 3, Points: 10}
+	err := publisher.Publish(context.Background(), Event{Type: EventTypeTaskCompleted, Payload: payload})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(repo.pending) != 1 {
+		t.Fatalf("esperado 1 evento no outbox, obtido %d", len(repo.pending))
+	}
+
+	stored := repo.pending[0]
+	if stored.Status != domain.OutboxPending {
+		t.Errorf("esperado status %s, obtido %s", domain.OutboxPending, stored.Status)
+	}
+	if stored.OccurredAt.IsZero() {
+		t.Error("esperado occurred_at preenchido")
+	}
+
+	decoded, err := DecodePayload(EventType(stored.EventType), stored.Payload)
+	if err != nil {
+		t.Fatalf("erro ao decodificar payload: %v", err)
+	}
+	if decoded != payload {
+		t.Errorf("esperado payload %+v, obtido %+v", payload, decoded)
+	}
+}
+
+func TestRelay_Run(t *testing.T) {
+	completedPayload, _ := EncodePayload(TaskCompletedPayload{TaskID: 1, CompletedBy: 2, TenantID: 3, Points: 10})
+
+	tests := []struct {
+		name            string
+		event           domain.OutboxEvent
+		handlerErr      error
+		handlerPanics   bool
+		expectedCalls   int
+		expectDelivered bool
+		expectAttempts  int
+		expectDead      bool
+		expectBackoff   time.Duration
+	}{
+		{
+			name:            "marca como entregue quando os handlers têm sucesso",
+			event:           domain.OutboxEvent{ID: 1, EventType: string(EventTypeTaskCompleted), Payload: completedPayload},
+			expectedCalls:   1,
+			expectDelivered: true,
+		},
+		{
+			name:           "agenda nova tentativa com backoff quando o handler falha",
+			event:          domain.OutboxEvent{ID: 1, EventType: string(EventTypeTaskCompleted), Payload: completedPayload, Attempts: 1},
+			handlerErr:     errors.New("handler error"),
+			expectedCalls:  1,
+			expectAttempts: 2,
+			expectBackoff:  2 * time.Second,
+		},
+		{
+			name:           "trata panic do handler como falha",
+			event:          domain.OutboxEvent{ID: 1, EventType: string(EventTypeTaskCompleted), Payload: completedPayload},
+			handlerPanics:  true,
+			expectedCalls:  1,
+			expectAttempts: 1,
+			expectBackoff:  time.Second,
+		},
+		{
+			name:           "move para dead letter ao atingir o máximo de tentativas",
+			event:          domain.OutboxEvent{ID: 1, EventType: string(EventTypeTaskCompleted), Payload: completedPayload, Attempts: 2},
+			handlerErr:     errors.New("handler error"),
+			expectedCalls:  1,
+			expectAttempts: 3,
+			expectDead:     true,
+		},
+		{
+			name:           "move para dead letter quando o payload não pode ser decodificado",
+			event:          domain.OutboxEvent{ID: 1, EventType: string(EventTypeTaskCompleted), Payload: []byte("{invalid")},
+			expectedCalls:  0,
+			expectAttempts: 1,
+			expectDead:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeOutboxRepository{pending: []domain.OutboxEvent{tt.event}}
+			relay := NewRelay(repo, fakeTransactor{}, testRelayConfig())
+
+			calls := 0
+			relay.RegisterHandler(EventTypeTaskCompleted, "test", func(ctx context.Context, event Event) error {
+				calls++
+				if tt.handlerPanics {
+					panic("boom")
+				}
+				return tt.handlerErr
+			})
+
+			before := time.Now()
+			if err := relay.Run(context.Background()); err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if calls != tt.expectedCalls {
+				t.Errorf("esperado %d chamadas ao handler, obtido %d", tt.expectedCalls, calls)
+			}
+
+			if tt.expectDelivered {
+				if len(repo.delivered) != 1 || repo.delivered[0] != tt.event.ID {
+					t.Errorf("esperado evento %d entregue, obtido %v", tt.event.ID, repo.delivered)
+				}
+				return
+			}
+
+			failed, ok := repo.failed[tt.event.ID]
+			if !ok {
+				t.Fatal("esperado evento marcado como falho")
+			}
+			if failed.attempts != tt.expectAttempts {
+				t.Errorf("esperado %d tentativas, obtido %d", tt.expectAttempts, failed.attempts)
+			}
+			if failed.dead != tt.expectDead {
+				t.Errorf("esperado dead=%v, obtido %v", tt.expectDead, failed.dead)
+			}
+			if failed.lastError == "" {
+				t.Error("esperado last_error preenchido")
+			}
+			if tt.expectBackoff > 0 && failed.availableAt.Before(before.Add(tt.expectBackoff)) {
+				t.Errorf("esperado backoff de pelo menos %v, obtido %v", tt.expectBackoff, failed.availableAt.Sub(before))
+			}
+		})
+	}
+}
+
+func TestRelay_RunRetriesOnlyFailedHandlers(t *testing.T) {
+	payload, _ := EncodePayload(TaskCompletedPayload{TaskID: 1, CompletedBy: 2, TenantID: 3, Points: 10})
+	event := domain.OutboxEvent{ID: 7, EventType: string(EventTypeTaskCompleted), Payload: payload}
+
+	repo := &fakeOutboxRepository{pending: []domain.OutboxEvent{event}}
+	relay := NewRelay(repo, fakeTransactor{}, testRelayConfig())
+
+	pointsCalls := 0
+	relay.RegisterHandler(EventTypeTaskCompleted, "points", func(ctx context.Context, event Event) error {
+		pointsCalls++
+		return nil
+	})
+
+	notificationCalls := 0
+	notificationErr := errors.New("notification error")
+	relay.RegisterHandler(EventTypeTaskCompleted, "notifications", func(ctx context.Context, event Event) error {
+		notificationCalls++
+		return notificationErr
+	})
+
+	if err := relay.Run(context.Background()); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if _, ok := repo.failed[event.ID]; !ok {
+		t.Fatal("esperado evento marcado como falho")
+	}
+
+	event.Attempts = repo.failed[event.ID].attempts
+	repo.pending = append(repo.pending, event)
+	notificationErr = nil
+
+	if err := relay.Run(context.Background()); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if pointsCalls != 1 {
+		t.Errorf("esperado 1 chamada ao handler de pontos, obtido %d", pointsCalls)
+	}
+	if notificationCalls != 2 {
+		t.Errorf("esperado 2 chamadas ao handler de notificações, obtido %d", notificationCalls)
+	}
+	if len(repo.delivered) != 1 || repo.delivered[0] != event.ID {
+		t.Errorf("esperado evento %d entregue, obtido %v", event.ID, repo.delivered)
+	}
+}
+
+func TestRelay_RunDrainsMultipleBatches(t *testing.T) {
+	payload, _ := EncodePayload(TaskAssignedPayload{TaskID: 1, AssigneeID: 2, AssignedBy: 3, TenantID: 4})
+
+	repo := &fakeOutboxRepository{}
+	for i := 1; i <= 25; i++ {
+		repo.pending = append(repo.pending, domain.OutboxEvent{ID: int64(i), EventType: string(EventTypeTaskAssigned), Payload: payload})
+	}
+
+	relay := NewRelay(repo, fakeTransactor{}, testRelayConfig())
+	relay.RegisterHandler(EventTypeTaskAssigned, "test", func(ctx context.Context, event Event) error {
+		return nil
+	})
+
+	if err := relay.Run(context.Background()); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(repo.delivered) != 25 {
+		t.Errorf("esperado 25 eventos entregues, obtido %d", len(repo.delivered))
+	}
+}
+
+func TestRelay_Backoff(t *testing.T) {
+	relay := NewRelay(&fakeOutboxRepository{}, fakeTransactor{}, testRelayConfig())
+
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{attempts: 1, expected: time.Second},
+		{attempts: 2, expected: 2 * time.Second},
+		{attempts: 4, expected: 8 * time.Second},
+		{attempts: 20, expected: time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := relay.backoff(tt.attempts); got != tt.expected {
+			t.Errorf("tentativa %d: esperado %v, obtido %v", tt.attempts, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package fairness
+
+import (
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type FairnessReport struct {
+	Period  domain.LeaderboardPeriod `json:"period"`
+	Start   time.Time                `json:"start"`
+	End     time.Time                `json:"end"`
+	Members []domain.FairnessShare   `json:"members"`
+}
+
+type CandidateShare struct {
+	domain.FairnessShare
+	Eligible bool `json:"eligible"`
+	Away     bool `json:"away"`
+}
+
+type SuggestedAssigneeResponse struct {
+	TaskID     int64                    `json:"task_id"`
+	TargetDate time.Time                `json:"target_date"`
+	Period     domain.LeaderboardPeriod `json:"period"`
+	Start      time.Time                `json:"start"`
+	End        time.Time                `json:"end"`
+	Suggested  *domain.FairnessShare    `json:"suggested"`
+	Candidates []CandidateShare         `json:"candidates"`
+}
@@ -0,0 +1,10 @@
+package fairness
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrTaskNotFound         = errors.New("task not found")
+	ErrInvalidPeriod        = errors.New("period must be week, month or rolling_30d")
+	ErrNoAvailableMember    = errors.New("no member is available for this task")
+)
@@ -0,0 +1,87 @@
+package fairness
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/domain"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Get("/api/v1/fairness", h.GetReport)
+	r.Get("/api/v1/tasks/{id}/suggested-assignee", h.SuggestAssignee)
+}
+
+func (h *Handler) GetReport(w http.ResponseWriter, r *http.Request) {
+	period := domain.LeaderboardPeriod(r.URL.Query().Get("period"))
+
+	report, err := h.service.GetReport(r.Context(), period, time.Now())
+	if err != nil {
+		respondWithFairnessError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, report)
+}
+
+func (h *Handler) SuggestAssignee(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID")
+		return
+	}
+
+	period := domain.LeaderboardPeriod(r.URL.Query().Get("period"))
+
+	suggestion, err := h.service.SuggestAssignee(r.Context(), id, period, time.Now())
+	if err != nil {
+		respondWithFairnessError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, suggestion)
+}
+
+func respondWithFairnessError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrUserNotAuthenticated) {
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+	if errors.Is(err, ErrTaskNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if errors.Is(err, ErrInvalidPeriod) {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, ErrNoAvailableMember) {
+		respondWithError(w, http.StatusConflict, err.Error())
+		return
+	}
+	respondWithError(w, http.StatusInternalServerError, err.Error())
+}
+
+func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(payload)
+}
+
+func respondWithError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
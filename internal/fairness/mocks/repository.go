@@ -0,0 +1,302 @@
+package mocks
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type MockTaskRepository struct {
+	CreateFunc                     func(ctx context.Context, task *domain.Task) error
+	FetchAllFunc                   func(ctx context.Context, tenantID int64) ([]domain.Task, error)
+	GetByIDFunc                    func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error)
+	GetByIDForUpdateFunc           func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error)
+	UpdateFunc                     func(ctx context.Context, task *domain.Task) error
+	DeleteFunc                     func(ctx context.Context, id int64, tenantID int64) error
+	GetUpcomingTasksFunc           func(ctx context.Context, tenantID int64, limit int, offset int) ([]domain.Task, error)
+	FetchAllByAssigneeFunc         func(ctx context.Context, tenantID int64, assigneeID int64) ([]domain.Task, error)
+	GetUpcomingTasksByAssigneeFunc func(ctx context.Context, tenantID int64, assigneeID int64, limit int, offset int) ([]domain.Task, error)
+	GetCompletedTasksHistoryFunc   func(ctx context.Context, tenantID int64, limit int) ([]domain.TaskWithUser, error)
+	GetCompletedTasksByUserFunc    func(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error)
+	FindNextOccurrenceFunc         func(ctx context.Context, taskID int64, tenantID int64) (*domain.Task, error)
+	GetPendingBySeriesFunc         func(ctx context.Context, seriesID int64, tenantID int64) ([]domain.Task, error)
+	GetSeriesHistoryFunc           func(ctx context.Context, seriesID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error)
+	MarkOverdueFunc                func(ctx context.Context, now time.Time) ([]domain.Task, error)
+	UnassignPendingFunc func(ctx context.Context, tenantID int64, assigneeID int64, from time.Time, to *time.Time, now time.Time) error
+}
+
+func (m *MockTaskRepository) Create(ctx context.Context, task *domain.Task) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, task)
+	}
+	return nil
+}
+
+func (m *MockTaskRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.Task, error) {
+	if m.FetchAllFunc != nil {
+		return m.FetchAllFunc(ctx, tenantID)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+	if m.GetByIDForUpdateFunc != nil {
+		return m.GetByIDForUpdateFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskRepository) Update(ctx context.Context, task *domain.Task) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, task)
+	}
+	return nil
+}
+
+func (m *MockTaskRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, tenantID)
+	}
+	return nil
+}
+
+func (m *MockTaskRepository) GetUpcomingTasks(ctx context.Context, tenantID int64, limit int, offset int) ([]domain.Task, error) {
+	if m.GetUpcomingTasksFunc != nil {
+		return m.GetUpcomingTasksFunc(ctx, tenantID, limit, offset)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) FetchAllByAssignee(ctx context.Context, tenantID int64, assigneeID int64) ([]domain.Task, error) {
+	if m.FetchAllByAssigneeFunc != nil {
+		return m.FetchAllByAssigneeFunc(ctx, tenantID, assigneeID)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) GetUpcomingTasksByAssignee(ctx context.Context, tenantID int64, assigneeID int64, limit int, offset int) ([]domain.Task, error) {
+	if m.GetUpcomingTasksByAssigneeFunc != nil {
+		return m.GetUpcomingTasksByAssigneeFunc(ctx, tenantID, assigneeID, limit, offset)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) GetCompletedTasksHistory(ctx context.Context, tenantID int64, limit int) ([]domain.TaskWithUser, error) {
+	if m.GetCompletedTasksHistoryFunc != nil {
+		return m.GetCompletedTasksHistoryFunc(ctx, tenantID, limit)
+	}
+	return []domain.TaskWithUser{}, nil
+}
+
+func (m *MockTaskRepository) GetCompletedTasksByUser(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error) {
+	if m.GetCompletedTasksByUserFunc != nil {
+		return m.GetCompletedTasksByUserFunc(ctx, userID, tenantID, limit, offset)
+	}
+	return []domain.TaskWithUser{}, nil
+}
+
+func (m *MockTaskRepository) FindNextOccurrence(ctx context.Context, taskID int64, tenantID int64) (*domain.Task, error) {
+	if m.FindNextOccurrenceFunc != nil {
+		return m.FindNextOccurrenceFunc(ctx, taskID, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskRepository) GetPendingBySeries(ctx context.Context, seriesID int64, tenantID int64) ([]domain.Task, error) {
+	if m.GetPendingBySeriesFunc != nil {
+		return m.GetPendingBySeriesFunc(ctx, seriesID, tenantID)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) GetSeriesHistory(ctx context.Context, seriesID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error) {
+	if m.GetSeriesHistoryFunc != nil {
+		return m.GetSeriesHistoryFunc(ctx, seriesID, tenantID, limit, offset)
+	}
+	return []domain.TaskWithUser{}, nil
+}
+
+func (m *MockTaskRepository) MarkOverdue(ctx context.Context, now time.Time) ([]domain.Task, error) {
+	if m.MarkOverdueFunc != nil {
+		return m.MarkOverdueFunc(ctx, now)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) UnassignPending(ctx context.Context, tenantID int64, assigneeID int64, from time.Time, to *time.Time, now time.Time) error {
+	if m.UnassignPendingFunc != nil {
+		return m.UnassignPendingFunc(ctx, tenantID, assigneeID, from, to, now)
+	}
+	return nil
+}
+
+type MockUserRepository struct {
+	GetByIDFunc  func(ctx context.Context, id int64, tenantID int64) (*domain.User, error)
+	FetchAllFunc func(ctx context.Context, tenantID int64) ([]domain.User, error)
+	UpdateFunc   func(ctx context.Context, user *domain.User) error
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return &domain.User{ID: id, TenantID: tenantID, Points: 0}, nil
+}
+
+func (m *MockUserRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	return m.GetByID(ctx, id, tenantID)
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, user)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *domain.User) error {
+	return nil
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) GetByEmailAndTenant(ctx context.Context, email string, tenantID int64) (*domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.User, error) {
+	if m.FetchAllFunc != nil {
+		return m.FetchAllFunc(ctx, tenantID)
+	}
+	return []domain.User{}, nil
+}
+
+func (m *MockUserRepository) GetTopUsersByPoints(ctx context.Context, tenantID int64, limit int) ([]domain.User, error) {
+	return nil, nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	return nil
+}
+
+type MockTaskRotationRepository struct {
+	CreateFunc             func(ctx context.Context, rotation *domain.TaskRotation) error
+	GetByIDFunc            func(ctx context.Context, id int64, tenantID int64) (*domain.TaskRotation, error)
+	UpdateFunc             func(ctx context.Context, rotation *domain.TaskRotation) error
+	GetLastCompletionsFunc func(ctx context.Context, rotationID int64, tenantID int64) (map[int64]time.Time, error)
+}
+
+func (m *MockTaskRotationRepository) Create(ctx context.Context, rotation *domain.TaskRotation) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, rotation)
+	}
+	return nil
+}
+
+func (m *MockTaskRotationRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.TaskRotation, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskRotationRepository) Update(ctx context.Context, rotation *domain.TaskRotation) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, rotation)
+	}
+	return nil
+}
+
+func (m *MockTaskRotationRepository) GetLastCompletions(ctx context.Context, rotationID int64, tenantID int64) (map[int64]time.Time, error) {
+	if m.GetLastCompletionsFunc != nil {
+		return m.GetLastCompletionsFunc(ctx, rotationID, tenantID)
+	}
+	return map[int64]time.Time{}, nil
+}
+
+type MockStatsRepository struct {
+	FetchUserCompletionsFunc    func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.UserCompletionStats, error)
+	FetchPointsEarnedFunc       func(ctx context.Context, tenantID int64, from time.Time, to time.Time) (map[int64]int, error)
+	FetchWeekdayCompletionsFunc func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.WeekdayCompletions, error)
+}
+
+func (m *MockStatsRepository) FetchUserCompletions(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.UserCompletionStats, error) {
+	if m.FetchUserCompletionsFunc != nil {
+		return m.FetchUserCompletionsFunc(ctx, tenantID, from, to)
+	}
+	return []domain.UserCompletionStats{}, nil
+}
+
+func (m *MockStatsRepository) FetchPointsEarned(ctx context.Context, tenantID int64, from time.Time, to time.Time) (map[int64]int, error) {
+	if m.FetchPointsEarnedFunc != nil {
+		return m.FetchPointsEarnedFunc(ctx, tenantID, from, to)
+	}
+	return map[int64]int{}, nil
+}
+
+func (m *MockStatsRepository) FetchWeekdayCompletions(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.WeekdayCompletions, error) {
+	if m.FetchWeekdayCompletionsFunc != nil {
+		return m.FetchWeekdayCompletionsFunc(ctx, tenantID, from, to)
+	}
+	return []domain.WeekdayCompletions{}, nil
+}
+
+type MockAbsenceRepository struct {
+	CreateFunc           func(ctx context.Context, absence *domain.Absence) error
+	GetByIDFunc          func(ctx context.Context, id int64, tenantID int64) (*domain.Absence, error)
+	FetchByUserFunc      func(ctx context.Context, userID int64, tenantID int64) ([]domain.Absence, error)
+	FetchOverlappingFunc func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error)
+	UpdateFunc           func(ctx context.Context, absence *domain.Absence) error
+	DeleteFunc           func(ctx context.Context, id int64, tenantID int64) error
+}
+
+func (m *MockAbsenceRepository) Create(ctx context.Context, absence *domain.Absence) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, absence)
+	}
+	return nil
+}
+
+func (m *MockAbsenceRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Absence, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockAbsenceRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64) ([]domain.Absence, error) {
+	if m.FetchByUserFunc != nil {
+		return m.FetchByUserFunc(ctx, userID, tenantID)
+	}
+	return []domain.Absence{}, nil
+}
+
+func (m *MockAbsenceRepository) FetchOverlapping(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error) {
+	if m.FetchOverlappingFunc != nil {
+		return m.FetchOverlappingFunc(ctx, tenantID, from, to)
+	}
+	return []domain.Absence{}, nil
+}
+
+func (m *MockAbsenceRepository) Update(ctx context.Context, absence *domain.Absence) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, absence)
+	}
+	return nil
+}
+
+func (m *MockAbsenceRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, tenantID)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package fairness
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"time"
+)
+
+type Service struct {
+	taskRepo     domain.TaskRepository
+	userRepo     domain.UserRepository
+	rotationRepo domain.TaskRotationRepository
+	statsRepo    domain.StatsRepository
+	absenceRepo  domain.AbsenceRepository
+}
+
+func NewService(taskRepo domain.TaskRepository, userRepo domain.UserRepository, rotationRepo domain.TaskRotationRepository, statsRepo domain.StatsRepository, absenceRepo domain.AbsenceRepository) *Service {
+	return &Service{
+		taskRepo:     taskRepo,
+		userRepo:     userRepo,
+		rotationRepo: rotationRepo,
+		statsRepo:    statsRepo,
+		absenceRepo:  absenceRepo,
+	}
+}
+
+func (s *Service) GetReport(ctx context.Context, period domain.LeaderboardPeriod, now time.Time) (*FairnessReport, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	period, window, err := resolveWindow(period, now)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, _, err := s.computeShares(ctx, tenantID, window, window.End)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FairnessReport{
+		Period:  period,
+		Start:   window.Start,
+		End:     window.End,
+		Members: shares,
+	}, nil
+}
+
+func (s *Service) SuggestAssignee(ctx context.Context, taskID int64, period domain.LeaderboardPeriod, now time.Time) (*SuggestedAssigneeResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	period, window, err := resolveWindow(period, now)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, taskID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task == nil {
+		return nil, ErrTaskNotFound
+	}
+
+	target := now
+	if task.ScheduledTo != nil {
+		target = *task.ScheduledTo
+	}
+
+	shares, absences, err := s.computeShares(ctx, tenantID, window, target)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotation *domain.TaskRotation
+	if task.RotationID != nil {
+		rotation, err = s.rotationRepo.GetByID(ctx, *task.RotationID, tenantID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	away := make(map[int64]bool, len(shares))
+	eligible := make(map[int64]bool, len(shares))
+	for _, share := range shares {
+		away[share.UserID] = domain.IsAbsent(absences, share.UserID, target)
+		eligible[share.UserID] = !away[share.UserID] && (rotation == nil || rotation.HasMember(share.UserID))
+	}
+
+	suggested := domain.MostBehind(shares, func(userID int64) bool {
+		return eligible[userID]
+	})
+	if suggested == nil {
+		return nil, ErrNoAvailableMember
+	}
+
+	candidates := make([]CandidateShare, len(shares))
+	for i, share := range shares {
+		candidates[i] = CandidateShare{
+			FairnessShare: share,
+			Eligible:      eligible[share.UserID],
+			Away:          away[share.UserID],
+		}
+	}
+
+	return &SuggestedAssigneeResponse{
+		TaskID:     task.ID,
+		TargetDate: target,
+		Period:     period,
+		Start:      window.Start,
+		End:        window.End,
+		Suggested:  suggested,
+		Candidates: candidates,
+	}, nil
+}
+
+func (s *Service) computeShares(ctx context.Context, tenantID int64, window domain.LeaderboardWindow, target time.Time) ([]domain.FairnessShare, []domain.Absence, error) {
+	users, err := s.userRepo.FetchAll(ctx, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	completions, err := s.statsRepo.FetchUserCompletions(ctx, tenantID, window.Start, window.End)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	from, to := window.Start, window.End
+	if target.Before(from) {
+		from = target
+	}
+	if next := domain.CalendarDay(target).AddDate(0, 0, 1); next.After(to) {
+		to = next
+	}
+
+	absences, err := s.absenceRepo.FetchOverlapping(ctx, tenantID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	effort := make(map[int64]int, len(completions))
+	for _, completion := range completions {
+		effort[completion.UserID] = completion.Effort
+	}
+
+	windowDays := int(domain.CalendarDay(window.End).Sub(domain.CalendarDay(window.Start)).Hours() / 24)
+
+	var inputs []domain.FairnessInput
+	for _, user := range users {
+		if user.Status != "active" {
+			continue
+		}
+
+		availableDays := windowDays
+		for i := range absences {
+			if absences[i].UserID == user.ID {
+				availableDays -= absences[i].OverlapDays(window.Start, window.End)
+			}
+		}
+		if availableDays < 0 {
+			availableDays = 0
+		}
+
+		inputs = append(inputs, domain.FairnessInput{
+			UserID:        user.ID,
+			Name:          user.Name,
+			Effort:        effort[user.ID],
+			AvailableDays: availableDays,
+		})
+	}
+
+	return domain.ComputeFairness(inputs), absences, nil
+}
+
+func resolveWindow(period domain.LeaderboardPeriod, now time.Time) (domain.LeaderboardPeriod, domain.LeaderboardWindow, error) {
+	if period == "" {
+		period = domain.LeaderboardRolling30
+	}
+
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	if period == domain.LeaderboardCustom || !period.IsValid() {
+		return period, domain.LeaderboardWindow{}, ErrInvalidPeriod
+	}
+
+	window, _, err := domain.ResolveLeaderboardWindows(period, now, nil, nil)
+	if err != nil {
+		return period, domain.LeaderboardWindow{}, ErrInvalidPeriod
+	}
+
+	return period, window, nil
+}
@@ -0,0 +1,166 @@
+package fairness
+
+import (
+	"context"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/fairness/mocks"
+	"keep-your-house-clean/internal/platform/middleware"
+	"testing"
+	"time"
+)
+
+func createContext(userID int64) context.Context {
+	ctx := middleware.SetUserIDInContext(context.Background(), userID)
+	return middleware.SetTenantIDInContext(ctx, 1)
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestService_SuggestAssignee(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	tomorrow := now.AddDate(0, 0, 1)
+
+	users := []domain.User{
+		{ID: 1, Name: "Ana", Status: "active"},
+		{ID: 2, Name: "Bruno", Status: "active"},
+		{ID: 3, Name: "Carla", Status: "active"},
+		{ID: 4, Name: "Davi", Status: "inactive"},
+	}
+	completions := []domain.UserCompletionStats{
+		{UserID: 1, Name: "Ana", Effort: 30},
+		{UserID: 2, Name: "Bruno", Effort: 5},
+		{UserID: 3, Name: "Carla", Effort: 15},
+	}
+
+	tests := []struct {
+		name          string
+		task          *domain.Task
+		rotation      *domain.TaskRotation
+		absences      []domain.Absence
+		expectedUser  int64
+		expectedError error
+	}{
+		{
+			name:         "sugere quem está mais atrás",
+			task:         &domain.Task{ID: 10, TenantID: 1, ScheduledTo: &tomorrow},
+			expectedUser: 2,
+		},
+		{
+			name: "pula quem estará ausente na data da tarefa",
+			task: &domain.Task{ID: 10, TenantID: 1, ScheduledTo: &tomorrow},
+			absences: []domain.Absence{
+				{UserID: 2, StartsOn: tomorrow, EndsOn: timePtr(tomorrow.AddDate(0, 0, 3))},
+			},
+			expectedUser: 3,
+		},
+		{
+			name:         "restringe aos membros do rodízio",
+			task:         &domain.Task{ID: 10, TenantID: 1, RotationID: int64Ptr(5)},
+			rotation:     &domain.TaskRotation{ID: 5, TenantID: 1, MemberIDs: []int64{1, 3}},
+			expectedUser: 3,
+		},
+		{
+			name:     "nenhum membro disponível",
+			task:     &domain.Task{ID: 10, TenantID: 1, RotationID: int64Ptr(5)},
+			rotation: &domain.TaskRotation{ID: 5, TenantID: 1, MemberIDs: []int64{2}},
+			absences: []domain.Absence{
+				{UserID: 2, StartsOn: now.AddDate(0, 0, -1), EndsOn: timePtr(now.AddDate(0, 0, 1))},
+			},
+			expectedError: ErrNoAvailableMember,
+		},
+		{
+			name:          "tarefa inexistente",
+			expectedError: ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := &mocks.MockTaskRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return tt.task, nil
+				},
+			}
+			userRepo := &mocks.MockUserRepository{
+				FetchAllFunc: func(ctx context.Context, tenantID int64) ([]domain.User, error) {
+					return users, nil
+				},
+			}
+			rotationRepo := &mocks.MockTaskRotationRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.TaskRotation, error) {
+					return tt.rotation, nil
+				},
+			}
+			statsRepo := &mocks.MockStatsRepository{
+				FetchUserCompletionsFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.UserCompletionStats, error) {
+					return completions, nil
+				},
+			}
+			absenceRepo := &mocks.MockAbsenceRepository{
+				FetchOverlappingFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error) {
+					return tt.absences, nil
+				},
+			}
+
+			service := NewService(taskRepo, userRepo, rotationRepo, statsRepo, absenceRepo)
+			suggestion, err := service.SuggestAssignee(createContext(1), 10, "", now)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("esperado erro %v, obtido %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if suggestion.Suggested == nil || suggestion.Suggested.UserID != tt.expectedUser {
+				t.Fatalf("esperado usuário %d, obtido %+v", tt.expectedUser, suggestion.Suggested)
+			}
+			if len(suggestion.Candidates) != 3 {
+				t.Errorf("esperados 3 membros ativos, obtidos %d", len(suggestion.Candidates))
+			}
+			for _, candidate := range suggestion.Candidates {
+				if candidate.Away && candidate.Eligible {
+					t.Errorf("membro ausente %d não deveria ser elegível", candidate.UserID)
+				}
+			}
+		})
+	}
+}
+
+func TestService_GetReport_AbsenceReducesExpectedShare(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+
+	userRepo := &mocks.MockUserRepository{
+		FetchAllFunc: func(ctx context.Context, tenantID int64) ([]domain.User, error) {
+			return []domain.User{{ID: 1, Name: "Ana", Status: "active"}, {ID: 2, Name: "Bruno", Status: "active"}}, nil
+		},
+	}
+	absenceRepo := &mocks.MockAbsenceRepository{
+		FetchOverlappingFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error) {
+			return []domain.Absence{{UserID: 1, StartsOn: now.AddDate(0, 0, -20), EndsOn: timePtr(now.AddDate(0, 0, -1))}}, nil
+		},
+	}
+
+	service := NewService(&mocks.MockTaskRepository{}, userRepo, &mocks.MockTaskRotationRepository{}, &mocks.MockStatsRepository{}, absenceRepo)
+	report, err := service.GetReport(createContext(1), domain.LeaderboardRolling30, now)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	expected := map[int64]float64{1: 25, 2: 75}
+	for _, member := range report.Members {
+		if member.ExpectedShare != expected[member.UserID] {
+			t.Errorf("usuário %d: parcela esperada %.2f, obtida %.2f", member.UserID, expected[member.UserID], member.ExpectedShare)
+		}
+	}
+}
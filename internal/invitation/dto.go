@@ -0,0 +1,13 @@
+package invitation
+
+import "keep-your-house-clean/internal/domain"
+
+type CreateInvitationRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type CreateInvitationResponse struct {
+	domain.Invitation
+	Token string `json:"token"`
+}
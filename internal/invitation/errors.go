@@ -0,0 +1,11 @@
+package invitation
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrInvitationNotFound   = errors.New("invitation not found")
+	ErrInvalidEmail         = errors.New("email is required")
+	ErrInvalidRole          = errors.New("invalid role")
+	ErrEmailExists          = errors.New("email already exists")
+)
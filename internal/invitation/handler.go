@@ -0,0 +1,99 @@
+package invitation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/platform/middleware"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/api/v1/invitations", func(r chi.Router) {
+		r.Use(middleware.RequirePermission(middleware.PermissionManageUsers))
+		r.Get("/", h.ListInvitations)
+		r.Post("/", h.CreateInvitation)
+		r.Delete("/{id}", h.RevokeInvitation)
+	})
+}
+
+func (h *Handler) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	var req CreateInvitationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	invitation, err := h.service.CreateInvitation(r.Context(), req)
+	if err != nil {
+		respondWithServiceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, invitation)
+}
+
+func (h *Handler) ListInvitations(w http.ResponseWriter, r *http.Request) {
+	invitations, err := h.service.ListPendingInvitations(r.Context())
+	if err != nil {
+		respondWithServiceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, invitations)
+}
+
+func (h *Handler) RevokeInvitation(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid invitation ID")
+		return
+	}
+
+	if err := h.service.RevokeInvitation(r.Context(), id); err != nil {
+		respondWithServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func respondWithServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrUserNotAuthenticated):
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+	case middleware.IsForbidden(err):
+		respondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ErrInvitationNotFound):
+		respondWithError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrInvalidEmail), errors.Is(err, ErrInvalidRole):
+		respondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrEmailExists):
+		respondWithError(w, http.StatusConflict, err.Error())
+	default:
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(payload)
+}
+
+func respondWithError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
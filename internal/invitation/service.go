@@ -0,0 +1,120 @@
+package invitation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"keep-your-house-clean/internal/platform/security"
+	"time"
+)
+
+const invitationTTL = 7 * 24 * time.Hour
+
+type Service struct {
+	repo     domain.InvitationRepository
+	userRepo domain.UserRepository
+}
+
+func NewService(repo domain.InvitationRepository, userRepo domain.UserRepository) *Service {
+	return &Service{
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+func (s *Service) CreateInvitation(ctx context.Context, req CreateInvitationRequest) (*CreateInvitationResponse, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	email := domain.NormalizeEmail(req.Email)
+	if email == "" {
+		return nil, ErrInvalidEmail
+	}
+
+	role := req.Role
+	if role == "" {
+		role = domain.RoleUser
+	}
+	if role != domain.RoleAdmin && role != domain.RoleUser {
+		return nil, ErrInvalidRole
+	}
+
+	existingUser, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if existingUser != nil {
+		return nil, ErrEmailExists
+	}
+
+	if err := s.repo.RevokePendingByEmail(ctx, email, tenantID); err != nil {
+		return nil, err
+	}
+
+	token, err := security.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invitation := &domain.Invitation{
+		TenantID:    tenantID,
+		Email:       email,
+		Role:        role,
+		TokenHash:   security.HashToken(token),
+		ExpiresAt:   now.Add(invitationTTL),
+		CreatedAt:   now,
+		CreatedById: userID,
+	}
+
+	if err := s.repo.Create(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	return &CreateInvitationResponse{
+		Invitation: *invitation,
+		Token:      token,
+	}, nil
+}
+
+func (s *Service) ListPendingInvitations(ctx context.Context) ([]domain.Invitation, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FetchPending(ctx, tenantID)
+}
+
+func (s *Service) RevokeInvitation(ctx context.Context, id int64) error {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageUsers); err != nil {
+		return err
+	}
+
+	if err := s.repo.Revoke(ctx, id, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrInvitationNotFound
+		}
+		return err
+	}
+
+	return nil
+}
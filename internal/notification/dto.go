@@ -0,0 +1,9 @@
+package notification
+
+type MarkAsReadRequest struct {
+	IDs []int64 `json:"ids"`
+}
+
+type UnreadCountResponse struct {
+	Count int `json:"count"`
+}
@@ -0,0 +1,7 @@
+package notification
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+)
@@ -0,0 +1,111 @@
+package notification
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/api/v1/notifications", func(r chi.Router) {
+		r.Get("/", h.ListNotifications)
+		r.Get("/unread-count", h.GetUnreadCount)
+		r.Post("/mark-read", h.MarkAsRead)
+		r.Post("/mark-all-read", h.MarkAllAsRead)
+	})
+}
+
+func (h *Handler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	limit := 20
+	offset := 0
+
+	if limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	if offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	unreadOnly := r.URL.Query().Get("unread") == "true"
+
+	notifications, err := h.service.ListNotifications(r.Context(), unreadOnly, limit, offset)
+	if err != nil {
+		respondWithNotificationError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, notifications)
+}
+
+func (h *Handler) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	count, err := h.service.GetUnreadCount(r.Context())
+	if err != nil {
+		respondWithNotificationError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, UnreadCountResponse{Count: count})
+}
+
+func (h *Handler) MarkAsRead(w http.ResponseWriter, r *http.Request) {
+	var req MarkAsReadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.service.MarkAsRead(r.Context(), req.IDs); err != nil {
+		respondWithNotificationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) MarkAllAsRead(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.MarkAllAsRead(r.Context()); err != nil {
+		respondWithNotificationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func respondWithNotificationError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrUserNotAuthenticated) {
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+	respondWithError(w, http.StatusInternalServerError, err.Error())
+}
+
+func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(payload)
+}
+
+func respondWithError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
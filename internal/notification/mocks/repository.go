@@ -0,0 +1,49 @@
+package mocks
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+)
+
+type MockNotificationRepository struct {
+	CreateFunc        func(ctx context.Context, notification *domain.Notification) error
+	FetchByUserFunc   func(ctx context.Context, userID int64, tenantID int64, unreadOnly bool, limit int, offset int) ([]domain.Notification, error)
+	CountUnreadFunc   func(ctx context.Context, userID int64, tenantID int64) (int, error)
+	MarkAsReadFunc    func(ctx context.Context, ids []int64, userID int64, tenantID int64) error
+	MarkAllAsReadFunc func(ctx context.Context, userID int64, tenantID int64) error
+}
+
+func (m *MockNotificationRepository) Create(ctx context.Context, notification *domain.Notification) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, notification)
+	}
+	return nil
+}
+
+func (m *MockNotificationRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64, unreadOnly bool, limit int, offset int) ([]domain.Notification, error) {
+	if m.FetchByUserFunc != nil {
+		return m.FetchByUserFunc(ctx, userID, tenantID, unreadOnly, limit, offset)
+	}
+	return []domain.Notification{}, nil
+}
+
+func (m *MockNotificationRepository) CountUnread(ctx context.Context, userID int64, tenantID int64) (int, error) {
+	if m.CountUnreadFunc != nil {
+		return m.CountUnreadFunc(ctx, userID, tenantID)
+	}
+	return 0, nil
+}
+
+func (m *MockNotificationRepository) MarkAsRead(ctx context.Context, ids []int64, userID int64, tenantID int64) error {
+	if m.MarkAsReadFunc != nil {
+		return m.MarkAsReadFunc(ctx, ids, userID, tenantID)
+	}
+	return nil
+}
+
+func (m *MockNotificationRepository) MarkAllAsRead(ctx context.Context, userID int64, tenantID int64) error {
+	if m.MarkAllAsReadFunc != nil {
+		return m.MarkAllAsReadFunc(ctx, userID, tenantID)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+)
+
+type Service struct {
+	repo domain.NotificationRepository
+}
+
+func NewService(repo domain.NotificationRepository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) ListNotifications(ctx context.Context, unreadOnly bool, limit int, offset int) ([]domain.Notification, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	notifications, err := s.repo.FetchByUser(ctx, userID, tenantID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if notifications == nil {
+		notifications = []domain.Notification{}
+	}
+
+	return notifications, nil
+}
+
+func (s *Service) GetUnreadCount(ctx context.Context) (int, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return 0, ErrUserNotAuthenticated
+	}
+
+	return s.repo.CountUnread(ctx, userID, tenantID)
+}
+
+func (s *Service) MarkAsRead(ctx context.Context, ids []int64) error {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return ErrUserNotAuthenticated
+	}
+
+	return s.repo.MarkAsRead(ctx, ids, userID, tenantID)
+}
+
+func (s *Service) MarkAllAsRead(ctx context.Context) error {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return ErrUserNotAuthenticated
+	}
+
+	return s.repo.MarkAllAsRead(ctx, userID, tenantID)
+}
@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/notification/mocks"
+	"keep-your-house-clean/internal/platform/middleware"
+	"testing"
+)
+
+func createContext(userID int64, tenantID int64) context.Context {
+	ctx := middleware.SetUserIDInContext(context.Background(), userID)
+	return middleware.SetTenantIDInContext(ctx, tenantID)
+}
+
+func TestService_ListNotifications(t *testing.T) {
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		unreadOnly    bool
+		repoResult    []domain.Notification
+		expectedCount int
+		expectedError error
+	}{
+		{
+			name:          "lista notificações do usuário",
+			ctx:           createContext(1, 1),
+			repoResult:    []domain.Notification{{ID: 1, UserID: 1}, {ID: 2, UserID: 1}},
+			expectedCount: 2,
+		},
+		{
+			name:          "retorna lista vazia quando não há notificações",
+			ctx:           createContext(1, 1),
+			unreadOnly:    true,
+			repoResult:    nil,
+			expectedCount: 0,
+		},
+		{
+			name:          "erro quando usuário não autenticado",
+			ctx:           context.Background(),
+			expectedError: ErrUserNotAuthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.MockNotificationRepository{
+				FetchByUserFunc: func(ctx context.Context, userID int64, tenantID int64, unreadOnly bool, limit int, offset int) ([]domain.Notification, error) {
+					if userID != 1 || tenantID != 1 {
+						t.Errorf("usuário/tenant inesperados: %d/%d", userID, tenantID)
+					}
+					if unreadOnly != tt.unreadOnly {
+						t.Errorf("filtro de não lidas esperado %v, obtido %v", tt.unreadOnly, unreadOnly)
+					}
+					return tt.repoResult, nil
+				},
+			}
+
+			notifications, err := NewService(repo).ListNotifications(tt.ctx, tt.unreadOnly, 20, 0)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("erro esperado %v, obtido %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if notifications == nil {
+				t.Fatal("lista não deveria ser nil")
+			}
+			if len(notifications) != tt.expectedCount {
+				t.Errorf("quantidade esperada %d, obtida %d", tt.expectedCount, len(notifications))
+			}
+		})
+	}
+}
+
+func TestService_MarkAsRead(t *testing.T) {
+	var markedIDs []int64
+	var markedUser int64
+	repo := &mocks.MockNotificationRepository{
+		MarkAsReadFunc: func(ctx context.Context, ids []int64, userID int64, tenantID int64) error {
+			markedIDs = ids
+			markedUser = userID
+			return nil
+		},
+	}
+
+	if err := NewService(repo).MarkAsRead(createContext(3, 1), []int64{4, 5}); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(markedIDs) != 2 || markedUser != 3 {
+		t.Errorf("marcação incorreta: ids %v, usuário %d", markedIDs, markedUser)
+	}
+
+	if err := NewService(repo).MarkAllAsRead(context.Background()); !errors.Is(err, ErrUserNotAuthenticated) {
+		t.Errorf("erro esperado %v, obtido %v", ErrUserNotAuthenticated, err)
+	}
+}
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type AbsenceRepository struct {
+	db *sql.DB
+}
+
+func NewAbsenceRepository(db *sql.DB) domain.AbsenceRepository {
+	return &AbsenceRepository{db: db}
+}
+
+func (r *AbsenceRepository) Create(ctx context.Context, absence *domain.Absence) error {
+	query := `
+		INSERT INTO user_absences (tenant_id, user_id, starts_on, ends_on, reason, created_at, created_by_id, updated_at)
+		VALUES ($1, $2, $3::date, $4::date, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		absence.TenantID,
+		absence.UserID,
+		dateParam(absence.StartsOn),
+		nullableDateParam(absence.EndsOn),
+		absence.Reason,
+		absence.CreatedAt,
+		absence.CreatedById,
+		absence.UpdatedAt,
+	).Scan(&absence.ID)
+}
+
+func (r *AbsenceRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Absence, error) {
+	query := `
+		SELECT id, tenant_id, user_id, starts_on, ends_on, reason, created_at, created_by_id, updated_at
+		FROM user_absences
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	return r.scanOne(conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID))
+}
+
+func (r *AbsenceRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64) ([]domain.Absence, error) {
+	query := `
+		SELECT id, tenant_id, user_id, starts_on, ends_on, reason, created_at, created_by_id, updated_at
+		FROM user_absences
+		WHERE user_id = $1 AND tenant_id = $2
+		ORDER BY starts_on DESC, id DESC
+	`
+
+	return r.fetch(ctx, query, userID, tenantID)
+}
+
+func (r *AbsenceRepository) FetchOverlapping(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error) {
+	query := `
+		SELECT id, tenant_id, user_id, starts_on, ends_on, reason, created_at, created_by_id, updated_at
+		FROM user_absences
+		WHERE tenant_id = $1 AND (ends_on IS NULL OR ends_on >= $2::date) AND starts_on < $3::date
+		ORDER BY starts_on ASC, id ASC
+	`
+
+	return r.fetch(ctx, query, tenantID, dateParam(from), dateParam(to))
+}
+
+func (r *AbsenceRepository) Update(ctx context.Context, absence *domain.Absence) error {
+	query := `
+		UPDATE user_absences SET
+			starts_on = $1::date,
+			ends_on = $2::date,
+			reason = $3,
+			updated_at = $4
+		WHERE id = $5 AND tenant_id = $6
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		dateParam(absence.StartsOn),
+		nullableDateParam(absence.EndsOn),
+		absence.Reason,
+		absence.UpdatedAt,
+		absence.ID,
+		absence.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AbsenceRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	query := `DELETE FROM user_absences WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AbsenceRepository) fetch(ctx context.Context, query string, args ...interface{}) ([]domain.Absence, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var absences []domain.Absence
+	for rows.Next() {
+		var absence domain.Absence
+		err := rows.Scan(
+			&absence.ID,
+			&absence.TenantID,
+			&absence.UserID,
+			&absence.StartsOn,
+			&absence.EndsOn,
+			&absence.Reason,
+			&absence.CreatedAt,
+			&absence.CreatedById,
+			&absence.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		absences = append(absences, absence)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return absences, nil
+}
+
+func (r *AbsenceRepository) scanOne(row *sql.Row) (*domain.Absence, error) {
+	var absence domain.Absence
+	err := row.Scan(
+		&absence.ID,
+		&absence.TenantID,
+		&absence.UserID,
+		&absence.StartsOn,
+		&absence.EndsOn,
+		&absence.Reason,
+		&absence.CreatedAt,
+		&absence.CreatedById,
+		&absence.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &absence, nil
+}
+
+func dateParam(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+func nullableDateParam(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return dateParam(*t)
+}
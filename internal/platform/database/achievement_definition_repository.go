@@ -0,0 +1,172 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+)
+
+type AchievementDefinitionRepository struct {
+	db *sql.DB
+}
+
+func NewAchievementDefinitionRepository(db *sql.DB) domain.AchievementDefinitionRepository {
+	return &AchievementDefinitionRepository{db: db}
+}
+
+func (r *AchievementDefinitionRepository) Create(ctx context.Context, definition *domain.AchievementDefinition) error {
+	query := `
+		INSERT INTO achievement_definitions (tenant_id, code, name, description, metric, threshold, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		definition.TenantID,
+		definition.Code,
+		definition.Name,
+		definition.Description,
+		definition.Metric,
+		definition.Threshold,
+		definition.Active,
+		definition.CreatedAt,
+		definition.UpdatedAt,
+	).Scan(&definition.ID)
+}
+
+func (r *AchievementDefinitionRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.AchievementDefinition, error) {
+	query := `
+		SELECT id, tenant_id, code, name, description, metric, threshold, active, created_at, updated_at
+		FROM achievement_definitions
+		WHERE id = $1 AND (tenant_id IS NULL OR tenant_id = $2)
+	`
+
+	var definition domain.AchievementDefinition
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
+		&definition.ID,
+		&definition.TenantID,
+		&definition.Code,
+		&definition.Name,
+		&definition.Description,
+		&definition.Metric,
+		&definition.Threshold,
+		&definition.Active,
+		&definition.CreatedAt,
+		&definition.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &definition, nil
+}
+
+func (r *AchievementDefinitionRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.AchievementDefinition, error) {
+	query := `
+		SELECT id, tenant_id, code, name, description, metric, threshold, active, created_at, updated_at
+		FROM achievement_definitions
+		WHERE tenant_id IS NULL OR tenant_id = $1
+		ORDER BY metric ASC, threshold ASC, id ASC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var definitions []domain.AchievementDefinition
+	for rows.Next() {
+		var definition domain.AchievementDefinition
+		err := rows.Scan(
+			&definition.ID,
+			&definition.TenantID,
+			&definition.Code,
+			&definition.Name,
+			&definition.Description,
+			&definition.Metric,
+			&definition.Threshold,
+			&definition.Active,
+			&definition.CreatedAt,
+			&definition.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
+func (r *AchievementDefinitionRepository) Update(ctx context.Context, definition *domain.AchievementDefinition) error {
+	query := `
+		UPDATE achievement_definitions SET
+			code = $1,
+			name = $2,
+			description = $3,
+			metric = $4,
+			threshold = $5,
+			active = $6,
+			updated_at = $7
+		WHERE id = $8 AND tenant_id = $9
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		definition.Code,
+		definition.Name,
+		definition.Description,
+		definition.Metric,
+		definition.Threshold,
+		definition.Active,
+		definition.UpdatedAt,
+		definition.ID,
+		definition.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *AchievementDefinitionRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	query := `DELETE FROM achievement_definitions WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
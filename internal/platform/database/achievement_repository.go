@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type AchievementRepository struct {
+	db *sql.DB
+}
+
+func NewAchievementRepository(db *sql.DB) domain.AchievementRepository {
+	return &AchievementRepository{db: db}
+}
+
+func (r *AchievementRepository) MarkEventProcessed(ctx context.Context, eventID int64, userID int64) (bool, error) {
+	query := `
+		INSERT INTO achievement_processed_events (event_id, user_id, processed_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id, user_id) DO NOTHING
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, eventID, userID, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *AchievementRepository) IncrementProgress(ctx context.Context, tenantID int64, userID int64, metric domain.AchievementMetric, delta int) (int, error) {
+	query := `
+		INSERT INTO achievement_progress (tenant_id, user_id, metric, value, updated_at)
+		VALUES ($1, $2, $3, GREATEST($4::integer, 0), $5)
+		ON CONFLICT (user_id, metric) DO UPDATE SET
+			value = GREATEST(achievement_progress.value + $4::integer, 0),
+			updated_at = EXCLUDED.updated_at
+		RETURNING value
+	`
+
+	var value int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, tenantID, userID, metric, delta, time.Now()).Scan(&value)
+	return value, err
+}
+
+func (r *AchievementRepository) RecordActivityDay(ctx context.Context, tenantID int64, userID int64, metric domain.AchievementMetric, day time.Time) (int, error) {
+	query := `
+		INSERT INTO achievement_progress (tenant_id, user_id, metric, value, last_activity_on, updated_at)
+		VALUES ($1, $2, $3, 1, $4::date, $5)
+		ON CONFLICT (user_id, metric) DO UPDATE SET
+			value = CASE
+				WHEN achievement_progress.last_activity_on IS NULL THEN 1
+				WHEN achievement_progress.last_activity_on >= EXCLUDED.last_activity_on THEN achievement_progress.value
+				WHEN achievement_progress.last_activity_on = EXCLUDED.last_activity_on - 1 THEN achievement_progress.value + 1
+				ELSE 1
+			END,
+			last_activity_on = GREATEST(achievement_progress.last_activity_on, EXCLUDED.last_activity_on),
+			updated_at = EXCLUDED.updated_at
+		RETURNING value
+	`
+
+	var value int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, tenantID, userID, metric, day.Format("2006-01-02"), time.Now()).Scan(&value)
+	return value, err
+}
+
+func (r *AchievementRepository) GetProgress(ctx context.Context, userID int64, tenantID int64) (map[domain.AchievementMetric]int, error) {
+	query := `SELECT metric, value FROM achievement_progress WHERE user_id = $1 AND tenant_id = $2`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	progress := make(map[domain.AchievementMetric]int)
+	for rows.Next() {
+		var metric domain.AchievementMetric
+		var value int
+		if err := rows.Scan(&metric, &value); err != nil {
+			return nil, err
+		}
+		progress[metric] = value
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
+
+func (r *AchievementRepository) Award(ctx context.Context, award *domain.UserAchievement) (bool, error) {
+	query := `
+		INSERT INTO user_achievements (tenant_id, user_id, achievement_id, awarded_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, achievement_id) DO NOTHING
+		RETURNING id
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, award.TenantID, award.UserID, award.AchievementID, award.AwardedAt).Scan(&award.ID)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *AchievementRepository) FetchAwardsByUser(ctx context.Context, userID int64, tenantID int64) ([]domain.UserAchievement, error) {
+	query := `
+		SELECT id, tenant_id, user_id, achievement_id, awarded_at
+		FROM user_achievements
+		WHERE user_id = $1 AND tenant_id = $2
+		ORDER BY awarded_at DESC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var awards []domain.UserAchievement
+	for rows.Next() {
+		var award domain.UserAchievement
+		if err := rows.Scan(&award.ID, &award.TenantID, &award.UserID, &award.AchievementID, &award.AwardedAt); err != nil {
+			return nil, err
+		}
+		awards = append(awards, award)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return awards, nil
+}
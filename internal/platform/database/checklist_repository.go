@@ -0,0 +1,230 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+)
+
+type ChecklistRepository struct {
+	db *sql.DB
+}
+
+func NewChecklistRepository(db *sql.DB) domain.ChecklistRepository {
+	return &ChecklistRepository{db: db}
+}
+
+func (r *ChecklistRepository) Create(ctx context.Context, item *domain.ChecklistItem) error {
+	query := `
+		INSERT INTO task_checklist_items (
+			tenant_id, task_id, position, title, checked_at, checked_by_id, created_at, created_by_id, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		item.TenantID,
+		item.TaskID,
+		item.Position,
+		item.Title,
+		item.CheckedAt,
+		item.CheckedById,
+		item.CreatedAt,
+		item.CreatedById,
+		item.UpdatedAt,
+	).Scan(&item.ID)
+}
+
+func (r *ChecklistRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.ChecklistItem, error) {
+	query := `
+		SELECT id, tenant_id, task_id, position, title, checked_at, checked_by_id, created_at, created_by_id, updated_at
+		FROM task_checklist_items
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	var item domain.ChecklistItem
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
+		&item.ID,
+		&item.TenantID,
+		&item.TaskID,
+		&item.Position,
+		&item.Title,
+		&item.CheckedAt,
+		&item.CheckedById,
+		&item.CreatedAt,
+		&item.CreatedById,
+		&item.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *ChecklistRepository) FetchByTask(ctx context.Context, taskID int64, tenantID int64) ([]domain.ChecklistItem, error) {
+	query := `
+		SELECT id, tenant_id, task_id, position, title, checked_at, checked_by_id, created_at, created_by_id, updated_at
+		FROM task_checklist_items
+		WHERE task_id = $1 AND tenant_id = $2
+		ORDER BY position ASC, id ASC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, taskID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []domain.ChecklistItem
+	for rows.Next() {
+		var item domain.ChecklistItem
+		err := rows.Scan(
+			&item.ID,
+			&item.TenantID,
+			&item.TaskID,
+			&item.Position,
+			&item.Title,
+			&item.CheckedAt,
+			&item.CheckedById,
+			&item.CreatedAt,
+			&item.CreatedById,
+			&item.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *ChecklistRepository) Update(ctx context.Context, item *domain.ChecklistItem) error {
+	query := `
+		UPDATE task_checklist_items SET
+			position = $1,
+			title = $2,
+			checked_at = $3,
+			checked_by_id = $4,
+			updated_at = $5
+		WHERE id = $6 AND tenant_id = $7
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		item.Position,
+		item.Title,
+		item.CheckedAt,
+		item.CheckedById,
+		item.UpdatedAt,
+		item.ID,
+		item.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *ChecklistRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	query := `DELETE FROM task_checklist_items WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *ChecklistRepository) RecordAudit(ctx context.Context, entry *domain.ChecklistAuditEntry) error {
+	query := `
+		INSERT INTO task_checklist_audit (tenant_id, task_id, item_id, item_title, user_id, action, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		entry.TenantID,
+		entry.TaskID,
+		entry.ItemID,
+		entry.ItemTitle,
+		entry.UserID,
+		entry.Action,
+		entry.CreatedAt,
+	).Scan(&entry.ID)
+}
+
+func (r *ChecklistRepository) FetchAudit(ctx context.Context, taskID int64, tenantID int64, limit int, offset int) ([]domain.ChecklistAuditEntry, error) {
+	query := `
+		SELECT id, tenant_id, task_id, item_id, item_title, user_id, action, created_at
+		FROM task_checklist_audit
+		WHERE task_id = $1 AND tenant_id = $2
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, taskID, tenantID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []domain.ChecklistAuditEntry
+	for rows.Next() {
+		var entry domain.ChecklistAuditEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.TenantID,
+			&entry.TaskID,
+			&entry.ItemID,
+			&entry.ItemTitle,
+			&entry.UserID,
+			&entry.Action,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -26,7 +26,7 @@ func (r *ComplimentRepository) Create(ctx context.Context, compliment *domain.Co
 		RETURNING id
 	`
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		compliment.Title,
@@ -59,7 +59,7 @@ func (r *ComplimentRepository) GetByID(ctx context.Context, id int64, tenantID i
 	`
 
 	var compliment domain.Compliment
-	err := r.db.QueryRowContext(ctx, query, id, tenantID).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
 		&compliment.ID,
 		&compliment.Title,
 		&compliment.Description,
@@ -94,7 +94,7 @@ func (r *ComplimentRepository) FetchAll(ctx context.Context, tenantID int64) ([]
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +144,7 @@ func (r *ComplimentRepository) GetLastReceivedByUser(ctx context.Context, userID
 	`
 
 	var compliment domain.ComplimentWithUser
-	err := r.db.QueryRowContext(ctx, query, tenantID, userID).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, tenantID, userID).Scan(
 		&compliment.ID,
 		&compliment.Title,
 		&compliment.Description,
@@ -188,7 +188,7 @@ func (r *ComplimentRepository) GetUserComplimentsHistory(ctx context.Context, us
 		ORDER BY c.created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID, userID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +240,7 @@ func (r *ComplimentRepository) GetUnviewedReceivedCompliments(ctx context.Contex
 		ORDER BY c.created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID, userID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +293,7 @@ func (r *ComplimentRepository) MarkAsViewed(ctx context.Context, ids []int64, us
 			AND deleted_at IS NULL
 	`
 
-	_, err := r.db.ExecContext(ctx, query, now, pq.Array(ids), tenantID, userID)
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, now, pq.Array(ids), tenantID, userID)
 	return err
 }
 
@@ -301,7 +301,7 @@ func (r *ComplimentRepository) Delete(ctx context.Context, id int64, tenantID in
 	now := time.Now()
 	query := `UPDATE compliments SET deleted_at = $1 WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL`
 
-	result, err := r.db.ExecContext(ctx, query, now, id, tenantID)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, now, id, tenantID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,204 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type InvitationRepository struct {
+	db *sql.DB
+}
+
+func NewInvitationRepository(db *sql.DB) domain.InvitationRepository {
+	return &InvitationRepository{db: db}
+}
+
+func (r *InvitationRepository) Create(ctx context.Context, invitation *domain.Invitation) error {
+	query := `
+		INSERT INTO invitations (
+			tenant_id, email, role, token_hash, expires_at, created_at, created_by_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	err := conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		invitation.TenantID,
+		invitation.Email,
+		invitation.Role,
+		invitation.TokenHash,
+		invitation.ExpiresAt,
+		invitation.CreatedAt,
+		invitation.CreatedById,
+	).Scan(&invitation.ID)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Invitation, error) {
+	query := `
+		SELECT id, tenant_id, email, role, token_hash, expires_at, accepted_at,
+		       accepted_by_id, revoked_at, created_at, created_by_id
+		FROM invitations
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	return r.scanOne(conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID))
+}
+
+func (r *InvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.Invitation, error) {
+	query := `
+		SELECT id, tenant_id, email, role, token_hash, expires_at, accepted_at,
+		       accepted_by_id, revoked_at, created_at, created_by_id
+		FROM invitations
+		WHERE token_hash = $1
+	`
+
+	return r.scanOne(conn(ctx, r.db).QueryRowContext(ctx, query, tokenHash))
+}
+
+func (r *InvitationRepository) FetchPending(ctx context.Context, tenantID int64) ([]domain.Invitation, error) {
+	query := `
+		SELECT id, tenant_id, email, role, token_hash, expires_at, accepted_at,
+		       accepted_by_id, revoked_at, created_at, created_by_id
+		FROM invitations
+		WHERE tenant_id = $1
+			AND accepted_at IS NULL
+			AND revoked_at IS NULL
+			AND expires_at > $2
+		ORDER BY created_at DESC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invitations []domain.Invitation
+	for rows.Next() {
+		var invitation domain.Invitation
+		err := rows.Scan(
+			&invitation.ID,
+			&invitation.TenantID,
+			&invitation.Email,
+			&invitation.Role,
+			&invitation.TokenHash,
+			&invitation.ExpiresAt,
+			&invitation.AcceptedAt,
+			&invitation.AcceptedById,
+			&invitation.RevokedAt,
+			&invitation.CreatedAt,
+			&invitation.CreatedById,
+		)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+func (r *InvitationRepository) Claim(ctx context.Context, id int64, acceptedAt time.Time) error {
+	query := `
+		UPDATE invitations
+		SET accepted_at = $1
+		WHERE id = $2 AND accepted_at IS NULL AND revoked_at IS NULL
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, acceptedAt, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) MarkAccepted(ctx context.Context, id int64, userID int64) error {
+	query := `UPDATE invitations SET accepted_by_id = $1 WHERE id = $2`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, userID, id)
+	return err
+}
+
+func (r *InvitationRepository) Revoke(ctx context.Context, id int64, tenantID int64) error {
+	query := `
+		UPDATE invitations
+		SET revoked_at = $1
+		WHERE id = $2 AND tenant_id = $3 AND accepted_at IS NULL AND revoked_at IS NULL
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *InvitationRepository) RevokePendingByEmail(ctx context.Context, email string, tenantID int64) error {
+	query := `
+		UPDATE invitations
+		SET revoked_at = $1
+		WHERE email = $2 AND tenant_id = $3 AND accepted_at IS NULL AND revoked_at IS NULL
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), email, tenantID)
+	return err
+}
+
+func (r *InvitationRepository) scanOne(row *sql.Row) (*domain.Invitation, error) {
+	var invitation domain.Invitation
+	err := row.Scan(
+		&invitation.ID,
+		&invitation.TenantID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.TokenHash,
+		&invitation.ExpiresAt,
+		&invitation.AcceptedAt,
+		&invitation.AcceptedById,
+		&invitation.RevokedAt,
+		&invitation.CreatedAt,
+		&invitation.CreatedById,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &invitation, nil
+}
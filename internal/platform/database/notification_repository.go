@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) domain.NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, notification *domain.Notification) error {
+	query := `
+		INSERT INTO notifications (tenant_id, user_id, type, title, body, task_id, read_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		notification.TenantID,
+		notification.UserID,
+		notification.Type,
+		notification.Title,
+		notification.Body,
+		notification.TaskID,
+		notification.ReadAt,
+		notification.CreatedAt,
+	).Scan(&notification.ID)
+}
+
+func (r *NotificationRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64, unreadOnly bool, limit int, offset int) ([]domain.Notification, error) {
+	query := `
+		SELECT id, tenant_id, user_id, type, title, body, task_id, read_at, created_at
+		FROM notifications
+		WHERE user_id = $1 AND tenant_id = $2 AND ($3::boolean = false OR read_at IS NULL)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $4 OFFSET $5
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, tenantID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []domain.Notification
+	for rows.Next() {
+		var notification domain.Notification
+		err := rows.Scan(
+			&notification.ID,
+			&notification.TenantID,
+			&notification.UserID,
+			&notification.Type,
+			&notification.Title,
+			&notification.Body,
+			&notification.TaskID,
+			&notification.ReadAt,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID int64, tenantID int64) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND tenant_id = $2 AND read_at IS NULL`
+
+	var count int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, tenantID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *NotificationRepository) MarkAsRead(ctx context.Context, ids []int64, userID int64, tenantID int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := `
+		UPDATE notifications
+		SET read_at = $1
+		WHERE id = ANY($2::bigint[])
+			AND user_id = $3
+			AND tenant_id = $4
+			AND read_at IS NULL
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), pq.Array(ids), userID, tenantID)
+	return err
+}
+
+func (r *NotificationRepository) MarkAllAsRead(ctx context.Context, userID int64, tenantID int64) error {
+	query := `UPDATE notifications SET read_at = $1 WHERE user_id = $2 AND tenant_id = $3 AND read_at IS NULL`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), userID, tenantID)
+	return err
+}
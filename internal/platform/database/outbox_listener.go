@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const outboxChannel = "outbox_events"
+
+type OutboxListener struct {
+	dsn          string
+	pingInterval time.Duration
+}
+
+func NewOutboxListener(dsn string, pingInterval time.Duration) *OutboxListener {
+	return &OutboxListener{dsn: dsn, pingInterval: pingInterval}
+}
+
+func (l *OutboxListener) Listen(ctx context.Context, notify func(ctx context.Context, id int64)) error {
+	listener := pq.NewListener(l.dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Outbox listener connection event %d: %v", event, err)
+		}
+	})
+	defer listener.Close()
+
+	if err := listener.Listen(outboxChannel); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(l.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case notification := <-listener.Notify:
+			if notification == nil {
+				continue
+			}
+
+			id, err := strconv.ParseInt(notification.Extra, 10, 64)
+			if err != nil {
+				log.Printf("Invalid outbox notification payload %q: %v", notification.Extra, err)
+				continue
+			}
+			notify(ctx, id)
+		case <-ticker.C:
+			if err := listener.Ping(); err != nil {
+				log.Printf("Error pinging outbox listener: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) domain.OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+func (r *OutboxRepository) Create(ctx context.Context, event *domain.OutboxEvent) error {
+	query := `
+		INSERT INTO outbox_events (event_type, payload, status, attempts, occurred_at, available_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		event.EventType,
+		event.Payload,
+		event.Status,
+		event.Attempts,
+		event.OccurredAt,
+		event.AvailableAt,
+		event.CreatedAt,
+	).Scan(&event.ID)
+}
+
+func (r *OutboxRepository) GetByID(ctx context.Context, id int64) (*domain.OutboxEvent, error) {
+	query := `
+		SELECT id, event_type, payload, status, attempts, last_error, occurred_at, available_at, delivered_at, created_at
+		FROM outbox_events
+		WHERE id = $1
+	`
+
+	var event domain.OutboxEvent
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
+		&event.ID,
+		&event.EventType,
+		&event.Payload,
+		&event.Status,
+		&event.Attempts,
+		&event.LastError,
+		&event.OccurredAt,
+		&event.AvailableAt,
+		&event.DeliveredAt,
+		&event.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r *OutboxRepository) ClaimPending(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]domain.OutboxEvent, error) {
+	query := `
+		UPDATE outbox_events SET available_at = $2
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE status = 'pending' AND available_at <= $1
+			ORDER BY id ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, event_type, payload, status, attempts, last_error, occurred_at, available_at, delivered_at, created_at
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []domain.OutboxEvent
+	for rows.Next() {
+		var event domain.OutboxEvent
+		err := rows.Scan(
+			&event.ID,
+			&event.EventType,
+			&event.Payload,
+			&event.Status,
+			&event.Attempts,
+			&event.LastError,
+			&event.OccurredAt,
+			&event.AvailableAt,
+			&event.DeliveredAt,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error {
+	query := `UPDATE outbox_events SET status = 'delivered', delivered_at = $1, last_error = NULL WHERE id = $2`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, deliveredAt, id)
+	return err
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, attempts int, lastError string, availableAt time.Time, dead bool) error {
+	status := domain.OutboxPending
+	if dead {
+		status = domain.OutboxDead
+	}
+
+	query := `UPDATE outbox_events SET status = $1, attempts = $2, last_error = $3, available_at = $4 WHERE id = $5`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, status, attempts, lastError, availableAt, id)
+	return err
+}
+
+func (r *OutboxRepository) DeliveredHandlers(ctx context.Context, id int64) ([]string, error) {
+	query := `SELECT handler FROM outbox_handler_deliveries WHERE event_id = $1`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var handlers []string
+	for rows.Next() {
+		var handler string
+		if err := rows.Scan(&handler); err != nil {
+			return nil, err
+		}
+		handlers = append(handlers, handler)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return handlers, nil
+}
+
+func (r *OutboxRepository) MarkHandlerDelivered(ctx context.Context, id int64, handler string, deliveredAt time.Time) error {
+	query := `
+		INSERT INTO outbox_handler_deliveries (event_id, handler, delivered_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (event_id, handler) DO NOTHING
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id, handler, deliveredAt)
+	return err
+}
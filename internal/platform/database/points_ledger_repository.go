@@ -0,0 +1,222 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type PointsLedgerRepository struct {
+	db *sql.DB
+}
+
+func NewPointsLedgerRepository(db *sql.DB) domain.PointsLedgerRepository {
+	return &PointsLedgerRepository{db: db}
+}
+
+func (r *PointsLedgerRepository) Post(ctx context.Context, entry *domain.PointsEntry) (bool, error) {
+	posted := false
+
+	err := NewTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		query := `
+			INSERT INTO points_ledger (tenant_id, user_id, delta, reason, source_type, source_id, idempotency_key, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (idempotency_key) DO NOTHING
+			RETURNING id
+		`
+
+		err := conn(ctx, r.db).QueryRowContext(
+			ctx,
+			query,
+			entry.TenantID,
+			entry.UserID,
+			entry.Delta,
+			entry.Reason,
+			entry.SourceType,
+			entry.SourceID,
+			entry.IdempotencyKey,
+			entry.CreatedAt,
+		).Scan(&entry.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = conn(ctx, r.db).ExecContext(
+			ctx,
+			`UPDATE users SET points = points + $1 WHERE id = $2 AND tenant_id = $3`,
+			entry.Delta,
+			entry.UserID,
+			entry.TenantID,
+		)
+		if err != nil {
+			return err
+		}
+
+		posted = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return posted, nil
+}
+
+func (r *PointsLedgerRepository) Spend(ctx context.Context, entry *domain.PointsEntry) (bool, error) {
+	posted := false
+
+	err := NewTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		query := `
+			INSERT INTO points_ledger (tenant_id, user_id, delta, reason, source_type, source_id, idempotency_key, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT (idempotency_key) DO NOTHING
+			RETURNING id
+		`
+
+		err := conn(ctx, r.db).QueryRowContext(
+			ctx,
+			query,
+			entry.TenantID,
+			entry.UserID,
+			entry.Delta,
+			entry.Reason,
+			entry.SourceType,
+			entry.SourceID,
+			entry.IdempotencyKey,
+			entry.CreatedAt,
+		).Scan(&entry.ID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		result, err := conn(ctx, r.db).ExecContext(
+			ctx,
+			`UPDATE users SET points = points + $1 WHERE id = $2 AND tenant_id = $3 AND points + $1 >= 0`,
+			entry.Delta,
+			entry.UserID,
+			entry.TenantID,
+		)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return domain.ErrInsufficientPoints
+		}
+
+		posted = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return posted, nil
+}
+
+func (r *PointsLedgerRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.PointsEntry, error) {
+	query := `
+		SELECT id, tenant_id, user_id, delta, reason, source_type, source_id, idempotency_key, created_at
+		FROM points_ledger
+		WHERE user_id = $1 AND tenant_id = $2
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, tenantID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []domain.PointsEntry
+	for rows.Next() {
+		var entry domain.PointsEntry
+		err := rows.Scan(
+			&entry.ID,
+			&entry.TenantID,
+			&entry.UserID,
+			&entry.Delta,
+			&entry.Reason,
+			&entry.SourceType,
+			&entry.SourceID,
+			&entry.IdempotencyKey,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *PointsLedgerRepository) GetBalance(ctx context.Context, userID int64, tenantID int64) (int, error) {
+	query := `SELECT COALESCE(SUM(delta), 0) FROM points_ledger WHERE user_id = $1 AND tenant_id = $2`
+
+	var balance int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, tenantID).Scan(&balance); err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+func (r *PointsLedgerRepository) SumByUser(ctx context.Context, tenantID int64, from time.Time, to time.Time, reasons []domain.PointsReason) (map[int64]int, error) {
+	query := `
+		SELECT user_id, COALESCE(SUM(delta), 0)
+		FROM points_ledger
+		WHERE tenant_id = $1
+			AND created_at >= $2
+			AND created_at < $3
+			AND reason = ANY($4::text[])
+		GROUP BY user_id
+	`
+
+	values := make([]string, len(reasons))
+	for i, reason := range reasons {
+		values[i] = string(reason)
+	}
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, from, to, pq.Array(values))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[int64]int)
+	for rows.Next() {
+		var userID int64
+		var total int
+		if err := rows.Scan(&userID, &total); err != nil {
+			return nil, err
+		}
+		totals[userID] = total
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
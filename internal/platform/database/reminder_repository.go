@@ -0,0 +1,284 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type ReminderRepository struct {
+	db *sql.DB
+}
+
+func NewReminderRepository(db *sql.DB) domain.ReminderRepository {
+	return &ReminderRepository{db: db}
+}
+
+func (r *ReminderRepository) Create(ctx context.Context, reminder *domain.Reminder) error {
+	query := `
+		INSERT INTO reminders (
+			tenant_id, user_id, task_id, series_id, trigger_type, offset_minutes, repeat_minutes,
+			channel, webhook_url, enabled, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		reminder.TenantID,
+		reminder.UserID,
+		reminder.TaskID,
+		reminder.SeriesID,
+		reminder.Trigger,
+		reminder.OffsetMinutes,
+		reminder.RepeatMinutes,
+		reminder.Channel,
+		reminder.WebhookURL,
+		reminder.Enabled,
+		reminder.CreatedAt,
+		reminder.UpdatedAt,
+	).Scan(&reminder.ID)
+}
+
+func (r *ReminderRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Reminder, error) {
+	query := `
+		SELECT id, tenant_id, user_id, task_id, series_id, trigger_type, offset_minutes, repeat_minutes,
+		       channel, webhook_url, enabled, created_at, updated_at
+		FROM reminders
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	var reminder domain.Reminder
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
+		&reminder.ID,
+		&reminder.TenantID,
+		&reminder.UserID,
+		&reminder.TaskID,
+		&reminder.SeriesID,
+		&reminder.Trigger,
+		&reminder.OffsetMinutes,
+		&reminder.RepeatMinutes,
+		&reminder.Channel,
+		&reminder.WebhookURL,
+		&reminder.Enabled,
+		&reminder.CreatedAt,
+		&reminder.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &reminder, nil
+}
+
+func (r *ReminderRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64) ([]domain.Reminder, error) {
+	query := `
+		SELECT id, tenant_id, user_id, task_id, series_id, trigger_type, offset_minutes, repeat_minutes,
+		       channel, webhook_url, enabled, created_at, updated_at
+		FROM reminders
+		WHERE user_id = $1 AND tenant_id = $2
+		ORDER BY created_at DESC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reminders []domain.Reminder
+	for rows.Next() {
+		var reminder domain.Reminder
+		err := rows.Scan(
+			&reminder.ID,
+			&reminder.TenantID,
+			&reminder.UserID,
+			&reminder.TaskID,
+			&reminder.SeriesID,
+			&reminder.Trigger,
+			&reminder.OffsetMinutes,
+			&reminder.RepeatMinutes,
+			&reminder.Channel,
+			&reminder.WebhookURL,
+			&reminder.Enabled,
+			&reminder.CreatedAt,
+			&reminder.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reminders, nil
+}
+
+func (r *ReminderRepository) Update(ctx context.Context, reminder *domain.Reminder) error {
+	query := `
+		UPDATE reminders SET
+			trigger_type = $1,
+			offset_minutes = $2,
+			repeat_minutes = $3,
+			channel = $4,
+			webhook_url = $5,
+			enabled = $6,
+			updated_at = $7
+		WHERE id = $8 AND tenant_id = $9
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		reminder.Trigger,
+		reminder.OffsetMinutes,
+		reminder.RepeatMinutes,
+		reminder.Channel,
+		reminder.WebhookURL,
+		reminder.Enabled,
+		reminder.UpdatedAt,
+		reminder.ID,
+		reminder.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *ReminderRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	query := `DELETE FROM reminders WHERE id = $1 AND tenant_id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *ReminderRepository) FindCandidates(ctx context.Context, now time.Time) ([]domain.ReminderCandidate, error) {
+	query := `
+		SELECT r.id, r.tenant_id, r.user_id, r.task_id, r.series_id, r.trigger_type, r.offset_minutes, r.repeat_minutes,
+		       r.channel, r.webhook_url, r.enabled, r.created_at, r.updated_at,
+		       t.id, t.title, t.scheduled_to, u.name, u.email
+		FROM reminders r
+		INNER JOIN users u ON u.id = r.user_id AND u.status = 'active' AND u.deleted_at IS NULL
+		INNER JOIN tasks t ON t.tenant_id = r.tenant_id
+			AND t.deleted_at IS NULL AND t.completed = false AND t.scheduled_to IS NOT NULL
+			AND (
+				t.id = r.task_id
+				OR t.series_id = r.series_id
+				OR (r.task_id IS NULL AND r.series_id IS NULL AND t.assignee_id = r.user_id)
+			)
+		WHERE r.enabled = true
+			AND NOT EXISTS (
+				SELECT 1 FROM task_series s WHERE s.id = t.series_id AND s.paused_at IS NOT NULL
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM user_absences a
+				WHERE a.user_id = r.user_id AND a.starts_on <= $1::timestamp::date AND (a.ends_on IS NULL OR a.ends_on >= $1::timestamp::date)
+			)
+			AND (
+				(r.trigger_type = 'before_due' AND t.scheduled_to > $1
+					AND t.scheduled_to - make_interval(mins => r.offset_minutes) <= $1)
+				OR (r.trigger_type = 'overdue' AND t.overdue_at IS NOT NULL)
+			)
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []domain.ReminderCandidate
+	for rows.Next() {
+		var candidate domain.ReminderCandidate
+		err := rows.Scan(
+			&candidate.Reminder.ID,
+			&candidate.Reminder.TenantID,
+			&candidate.Reminder.UserID,
+			&candidate.Reminder.TaskID,
+			&candidate.Reminder.SeriesID,
+			&candidate.Reminder.Trigger,
+			&candidate.Reminder.OffsetMinutes,
+			&candidate.Reminder.RepeatMinutes,
+			&candidate.Reminder.Channel,
+			&candidate.Reminder.WebhookURL,
+			&candidate.Reminder.Enabled,
+			&candidate.Reminder.CreatedAt,
+			&candidate.Reminder.UpdatedAt,
+			&candidate.TaskID,
+			&candidate.TaskTitle,
+			&candidate.ScheduledTo,
+			&candidate.UserName,
+			&candidate.UserEmail,
+		)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+func (r *ReminderRepository) RecordDelivery(ctx context.Context, reminderID int64, taskID int64, slot time.Time) (bool, error) {
+	query := `
+		INSERT INTO reminder_deliveries (reminder_id, task_id, slot, sent_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (reminder_id, task_id, slot) DO NOTHING
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, reminderID, taskID, slot)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *ReminderRepository) DeleteDelivery(ctx context.Context, reminderID int64, taskID int64, slot time.Time) error {
+	query := `DELETE FROM reminder_deliveries WHERE reminder_id = $1 AND task_id = $2 AND slot = $3`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, reminderID, taskID, slot)
+	return err
+}
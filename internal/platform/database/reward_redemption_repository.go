@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+)
+
+type RewardRedemptionRepository struct {
+	db *sql.DB
+}
+
+func NewRewardRedemptionRepository(db *sql.DB) domain.RewardRedemptionRepository {
+	return &RewardRedemptionRepository{db: db}
+}
+
+func (r *RewardRedemptionRepository) Create(ctx context.Context, redemption *domain.RewardRedemption) error {
+	query := `
+		INSERT INTO reward_redemptions (tenant_id, reward_id, user_id, cost, status, note, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		redemption.TenantID,
+		redemption.RewardID,
+		redemption.UserID,
+		redemption.Cost,
+		redemption.Status,
+		redemption.Note,
+		redemption.CreatedAt,
+	).Scan(&redemption.ID)
+}
+
+func (r *RewardRedemptionRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.RewardRedemption, error) {
+	query := `
+		SELECT rr.id, rr.tenant_id, rr.reward_id, rw.title, rr.user_id, rr.cost, rr.status, rr.note,
+		       rr.decided_by_id, rr.decided_at, rr.created_at
+		FROM reward_redemptions rr
+		INNER JOIN rewards rw ON rw.id = rr.reward_id
+		WHERE rr.id = $1 AND rr.tenant_id = $2
+	`
+
+	var redemption domain.RewardRedemption
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
+		&redemption.ID,
+		&redemption.TenantID,
+		&redemption.RewardID,
+		&redemption.RewardTitle,
+		&redemption.UserID,
+		&redemption.Cost,
+		&redemption.Status,
+		&redemption.Note,
+		&redemption.DecidedById,
+		&redemption.DecidedAt,
+		&redemption.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &redemption, nil
+}
+
+func (r *RewardRedemptionRepository) FetchAll(ctx context.Context, tenantID int64, userID *int64, status *domain.RedemptionStatus) ([]domain.RewardRedemption, error) {
+	query := `
+		SELECT rr.id, rr.tenant_id, rr.reward_id, rw.title, rr.user_id, rr.cost, rr.status, rr.note,
+		       rr.decided_by_id, rr.decided_at, rr.created_at
+		FROM reward_redemptions rr
+		INNER JOIN rewards rw ON rw.id = rr.reward_id
+		WHERE rr.tenant_id = $1
+			AND ($2::bigint IS NULL OR rr.user_id = $2)
+			AND ($3::text IS NULL OR rr.status = $3)
+		ORDER BY rr.created_at DESC, rr.id DESC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, userID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var redemptions []domain.RewardRedemption
+	for rows.Next() {
+		var redemption domain.RewardRedemption
+		err := rows.Scan(
+			&redemption.ID,
+			&redemption.TenantID,
+			&redemption.RewardID,
+			&redemption.RewardTitle,
+			&redemption.UserID,
+			&redemption.Cost,
+			&redemption.Status,
+			&redemption.Note,
+			&redemption.DecidedById,
+			&redemption.DecidedAt,
+			&redemption.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		redemptions = append(redemptions, redemption)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return redemptions, nil
+}
+
+func (r *RewardRedemptionRepository) Decide(ctx context.Context, redemption *domain.RewardRedemption) error {
+	query := `
+		UPDATE reward_redemptions SET
+			status = $1,
+			note = $2,
+			decided_by_id = $3,
+			decided_at = $4
+		WHERE id = $5 AND tenant_id = $6 AND status = 'pending'
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		redemption.Status,
+		redemption.Note,
+		redemption.DecidedById,
+		redemption.DecidedAt,
+		redemption.ID,
+		redemption.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type RewardRepository struct {
+	db *sql.DB
+}
+
+func NewRewardRepository(db *sql.DB) domain.RewardRepository {
+	return &RewardRepository{db: db}
+}
+
+func (r *RewardRepository) Create(ctx context.Context, reward *domain.Reward) error {
+	query := `
+		INSERT INTO rewards (tenant_id, title, description, cost, stock, active, created_at, created_by_id, updated_at, updated_by_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		reward.TenantID,
+		reward.Title,
+		reward.Description,
+		reward.Cost,
+		reward.Stock,
+		reward.Active,
+		reward.CreatedAt,
+		reward.CreatedById,
+		reward.UpdatedAt,
+		reward.UpdatedById,
+	).Scan(&reward.ID)
+}
+
+func (r *RewardRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Reward, error) {
+	query := `
+		SELECT id, tenant_id, title, description, cost, stock, active, created_at, created_by_id, updated_at, updated_by_id
+		FROM rewards
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+	`
+
+	var reward domain.Reward
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
+		&reward.ID,
+		&reward.TenantID,
+		&reward.Title,
+		&reward.Description,
+		&reward.Cost,
+		&reward.Stock,
+		&reward.Active,
+		&reward.CreatedAt,
+		&reward.CreatedById,
+		&reward.UpdatedAt,
+		&reward.UpdatedById,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &reward, nil
+}
+
+func (r *RewardRepository) FetchAll(ctx context.Context, tenantID int64, activeOnly bool) ([]domain.Reward, error) {
+	query := `
+		SELECT id, tenant_id, title, description, cost, stock, active, created_at, created_by_id, updated_at, updated_by_id
+		FROM rewards
+		WHERE tenant_id = $1 AND deleted_at IS NULL AND ($2::boolean = false OR active = true)
+		ORDER BY cost ASC, title ASC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rewards []domain.Reward
+	for rows.Next() {
+		var reward domain.Reward
+		err := rows.Scan(
+			&reward.ID,
+			&reward.TenantID,
+			&reward.Title,
+			&reward.Description,
+			&reward.Cost,
+			&reward.Stock,
+			&reward.Active,
+			&reward.CreatedAt,
+			&reward.CreatedById,
+			&reward.UpdatedAt,
+			&reward.UpdatedById,
+		)
+		if err != nil {
+			return nil, err
+		}
+		rewards = append(rewards, reward)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
+func (r *RewardRepository) Update(ctx context.Context, reward *domain.Reward) error {
+	query := `
+		UPDATE rewards SET
+			title = $1,
+			description = $2,
+			cost = $3,
+			stock = $4,
+			active = $5,
+			updated_at = $6,
+			updated_by_id = $7
+		WHERE id = $8 AND tenant_id = $9 AND deleted_at IS NULL
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		reward.Title,
+		reward.Description,
+		reward.Cost,
+		reward.Stock,
+		reward.Active,
+		reward.UpdatedAt,
+		reward.UpdatedById,
+		reward.ID,
+		reward.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *RewardRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	query := `UPDATE rewards SET deleted_at = $1 WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *RewardRepository) ReserveStock(ctx context.Context, id int64, tenantID int64) (bool, error) {
+	query := `
+		UPDATE rewards
+		SET stock = CASE WHEN stock IS NULL THEN NULL ELSE stock - 1 END
+		WHERE id = $1
+			AND tenant_id = $2
+			AND deleted_at IS NULL
+			AND active = true
+			AND (stock IS NULL OR stock > 0)
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *RewardRepository) ReleaseStock(ctx context.Context, id int64, tenantID int64) error {
+	query := `UPDATE rewards SET stock = stock + 1 WHERE id = $1 AND tenant_id = $2 AND stock IS NOT NULL`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id, tenantID)
+	return err
+}
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type StatsRepository struct {
+	db *sql.DB
+}
+
+func NewStatsRepository(db *sql.DB) domain.StatsRepository {
+	return &StatsRepository{db: db}
+}
+
+func (r *StatsRepository) FetchUserCompletions(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.UserCompletionStats, error) {
+	query := `
+		SELECT u.id, u.name, u.status,
+		       COUNT(t.id),
+		       COALESCE(SUM(t.points), 0),
+		       COALESCE(SUM(GREATEST(t.points, 1)) FILTER (WHERE t.id IS NOT NULL), 0),
+		       COUNT(t.scheduled_to),
+		       COUNT(*) FILTER (WHERE t.scheduled_to IS NOT NULL AND t.overdue_at IS NULL AND t.completed_at <= t.scheduled_to),
+		       AVG(EXTRACT(EPOCH FROM (t.completed_at - t.scheduled_to)))::double precision
+		FROM users u
+		LEFT JOIN tasks t ON t.completed_by_id = u.id
+			AND t.tenant_id = u.tenant_id
+			AND t.completed = true
+			AND t.deleted_at IS NULL
+			AND t.completed_at >= $2
+			AND t.completed_at < $3
+		WHERE u.tenant_id = $1 AND u.deleted_at IS NULL
+		GROUP BY u.id, u.name, u.status
+		ORDER BY COUNT(t.id) DESC, u.name ASC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []domain.UserCompletionStats
+	for rows.Next() {
+		var stat domain.UserCompletionStats
+		var avgDelay sql.NullFloat64
+		err := rows.Scan(
+			&stat.UserID,
+			&stat.Name,
+			&stat.Status,
+			&stat.Completed,
+			&stat.TaskPoints,
+			&stat.Effort,
+			&stat.Scheduled,
+			&stat.OnTime,
+			&avgDelay,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if avgDelay.Valid {
+			stat.AvgDelaySeconds = &avgDelay.Float64
+		}
+		stats = append(stats, stat)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (r *StatsRepository) FetchPointsEarned(ctx context.Context, tenantID int64, from time.Time, to time.Time) (map[int64]int, error) {
+	query := `
+		SELECT user_id, COALESCE(SUM(delta), 0)
+		FROM points_ledger
+		WHERE tenant_id = $1
+			AND created_at >= $2
+			AND created_at < $3
+			AND reason = ANY($4::text[])
+		GROUP BY user_id
+	`
+
+	reasons := make([]string, len(domain.LeaderboardReasons))
+	for i, reason := range domain.LeaderboardReasons {
+		reasons[i] = string(reason)
+	}
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, from, to, pq.Array(reasons))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[int64]int)
+	for rows.Next() {
+		var userID int64
+		var total int
+		if err := rows.Scan(&userID, &total); err != nil {
+			return nil, err
+		}
+		totals[userID] = total
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func (r *StatsRepository) FetchWeekdayCompletions(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.WeekdayCompletions, error) {
+	query := `
+		SELECT EXTRACT(DOW FROM completed_at)::integer, COUNT(*)
+		FROM tasks
+		WHERE tenant_id = $1
+			AND completed = true
+			AND deleted_at IS NULL
+			AND completed_at >= $2
+			AND completed_at < $3
+		GROUP BY 1
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var weekdays []domain.WeekdayCompletions
+	for rows.Next() {
+		var weekday int
+		var completed int
+		if err := rows.Scan(&weekday, &completed); err != nil {
+			return nil, err
+		}
+		weekdays = append(weekdays, domain.WeekdayCompletions{Weekday: time.Weekday(weekday), Completed: completed})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return weekdays, nil
+}
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type StreakRepository struct {
+	db *sql.DB
+}
+
+func NewStreakRepository(db *sql.DB) domain.StreakRepository {
+	return &StreakRepository{db: db}
+}
+
+func (r *StreakRepository) MarkEventProcessed(ctx context.Context, eventID int64) (bool, error) {
+	query := `
+		INSERT INTO streak_processed_events (event_id, processed_at)
+		VALUES ($1, $2)
+		ON CONFLICT (event_id) DO NOTHING
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, eventID, time.Now())
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *StreakRepository) Get(ctx context.Context, kind domain.StreakKind, subjectID int64, tenantID int64) (*domain.Streak, error) {
+	query := `
+		SELECT tenant_id, kind, subject_id, current, longest, last_on, updated_at
+		FROM streaks
+		WHERE kind = $1 AND subject_id = $2 AND tenant_id = $3
+	`
+
+	var streak domain.Streak
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, kind, subjectID, tenantID).Scan(
+		&streak.TenantID,
+		&streak.Kind,
+		&streak.SubjectID,
+		&streak.Current,
+		&streak.Longest,
+		&streak.LastOn,
+		&streak.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &streak, nil
+}
+
+func (r *StreakRepository) Save(ctx context.Context, streak *domain.Streak) error {
+	query := `
+		INSERT INTO streaks (tenant_id, kind, subject_id, current, longest, last_on, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (kind, subject_id) DO UPDATE SET
+			current = EXCLUDED.current,
+			longest = EXCLUDED.longest,
+			last_on = EXCLUDED.last_on,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		streak.TenantID,
+		streak.Kind,
+		streak.SubjectID,
+		streak.Current,
+		streak.Longest,
+		streak.LastOn,
+		streak.UpdatedAt,
+	)
+	return err
+}
+
+func (r *StreakRepository) AddActivity(ctx context.Context, tenantID int64, userID int64, day time.Time, delta int) (int, error) {
+	query := `
+		INSERT INTO streak_activity_days (tenant_id, user_id, day, completions)
+		VALUES ($1, $2, $3::date, GREATEST($4::integer, 0))
+		ON CONFLICT (user_id, day) DO UPDATE SET
+			completions = GREATEST(streak_activity_days.completions + $4::integer, 0)
+		RETURNING completions
+	`
+
+	var completions int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, tenantID, userID, day.Format("2006-01-02"), delta).Scan(&completions)
+	return completions, err
+}
+
+func (r *StreakRepository) FetchActivityDays(ctx context.Context, userID int64, tenantID int64) ([]time.Time, error) {
+	query := `
+		SELECT day
+		FROM streak_activity_days
+		WHERE user_id = $1 AND tenant_id = $2 AND completions > 0
+		ORDER BY day ASC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []time.Time
+	for rows.Next() {
+		var day time.Time
+		if err := rows.Scan(&day); err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+func (r *StreakRepository) RecordOccurrence(ctx context.Context, tenantID int64, seriesID int64, occurrence domain.StreakOccurrence) error {
+	query := `
+		INSERT INTO streak_occurrences (task_id, tenant_id, series_id, scheduled_to, completed_at, on_time)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (task_id) DO UPDATE SET
+			series_id = EXCLUDED.series_id,
+			scheduled_to = EXCLUDED.scheduled_to,
+			completed_at = EXCLUDED.completed_at,
+			on_time = EXCLUDED.on_time
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		occurrence.TaskID,
+		tenantID,
+		seriesID,
+		occurrence.ScheduledTo,
+		occurrence.CompletedAt,
+		occurrence.OnTime,
+	)
+	return err
+}
+
+func (r *StreakRepository) RemoveOccurrence(ctx context.Context, taskID int64, tenantID int64) error {
+	query := `DELETE FROM streak_occurrences WHERE task_id = $1 AND tenant_id = $2`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, taskID, tenantID)
+	return err
+}
+
+func (r *StreakRepository) FetchOccurrences(ctx context.Context, seriesID int64, tenantID int64) ([]domain.StreakOccurrence, error) {
+	query := `
+		SELECT task_id, scheduled_to, completed_at, on_time
+		FROM streak_occurrences
+		WHERE series_id = $1 AND tenant_id = $2
+		ORDER BY scheduled_to ASC NULLS FIRST, completed_at ASC, task_id ASC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, seriesID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var occurrences []domain.StreakOccurrence
+	for rows.Next() {
+		var occurrence domain.StreakOccurrence
+		if err := rows.Scan(&occurrence.TaskID, &occurrence.ScheduledTo, &occurrence.CompletedAt, &occurrence.OnTime); err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return occurrences, nil
+}
@@ -19,13 +19,13 @@ func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
 	query := `
 		INSERT INTO tasks (
 			title, description, points, status, scheduled_to, scheduled_by_id,
-			frequency_value, frequency_unit, completed, completed_by_id,
+			frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
 			tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24)
 		RETURNING id
 	`
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		task.Title,
@@ -38,6 +38,14 @@ func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
 		task.FrequencyUnit,
 		task.Completed,
 		task.CompletedById,
+		task.AssigneeID,
+		task.RotationID,
+		task.SeriesID,
+		task.PreviousTaskID,
+		task.RecurrenceRule,
+		task.AnchorMode,
+		task.OverdueAt,
+		task.CompletedAt,
 		task.TenantID,
 		task.CreatedAt,
 		task.CreatedById,
@@ -56,14 +64,14 @@ func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
 func (r *TaskRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.Task, error) {
 	query := `
 		SELECT id, title, description, points, status, scheduled_to, scheduled_by_id,
-		       frequency_value, frequency_unit, completed, completed_by_id,
+		       frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
 		       tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
 		FROM tasks
 		WHERE deleted_at IS NULL AND tenant_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +92,14 @@ func (r *TaskRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain
 			&task.FrequencyUnit,
 			&task.Completed,
 			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
 			&task.TenantID,
 			&task.CreatedAt,
 			&task.CreatedById,
@@ -105,16 +121,24 @@ func (r *TaskRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain
 }
 
 func (r *TaskRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+	return r.getByID(ctx, id, tenantID, "")
+}
+
+func (r *TaskRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+	return r.getByID(ctx, id, tenantID, "FOR UPDATE")
+}
+
+func (r *TaskRepository) getByID(ctx context.Context, id int64, tenantID int64, lock string) (*domain.Task, error) {
 	query := `
 		SELECT id, title, description, points, status, scheduled_to, scheduled_by_id,
-		       frequency_value, frequency_unit, completed, completed_by_id,
+		       frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
 		       tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
 		FROM tasks
 		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
-	`
+	` + lock
 
 	var task domain.Task
-	err := r.db.QueryRowContext(ctx, query, id, tenantID).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
 		&task.ID,
 		&task.Title,
 		&task.Description,
@@ -126,6 +150,14 @@ func (r *TaskRepository) GetByID(ctx context.Context, id int64, tenantID int64)
 		&task.FrequencyUnit,
 		&task.Completed,
 		&task.CompletedById,
+		&task.AssigneeID,
+		&task.RotationID,
+		&task.SeriesID,
+		&task.PreviousTaskID,
+		&task.RecurrenceRule,
+		&task.AnchorMode,
+		&task.OverdueAt,
+		&task.CompletedAt,
 		&task.TenantID,
 		&task.CreatedAt,
 		&task.CreatedById,
@@ -157,12 +189,19 @@ func (r *TaskRepository) Update(ctx context.Context, task *domain.Task) error {
 			frequency_unit = $8,
 			completed = $9,
 			completed_by_id = $10,
-			updated_at = $11,
-			updated_by_id = $12
-		WHERE id = $13 AND tenant_id = $14 AND deleted_at IS NULL
+			assignee_id = $11,
+			rotation_id = $12,
+			series_id = $13,
+			recurrence_rule = $14,
+			anchor_mode = $15,
+			overdue_at = $16,
+			completed_at = $17,
+			updated_at = $18,
+			updated_by_id = $19
+		WHERE id = $20 AND tenant_id = $21 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(
+	result, err := conn(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		task.Title,
@@ -175,6 +214,13 @@ func (r *TaskRepository) Update(ctx context.Context, task *domain.Task) error {
 		task.FrequencyUnit,
 		task.Completed,
 		task.CompletedById,
+		task.AssigneeID,
+		task.RotationID,
+		task.SeriesID,
+		task.RecurrenceRule,
+		task.AnchorMode,
+		task.OverdueAt,
+		task.CompletedAt,
 		task.UpdatedAt,
 		task.UpdatedById,
 		task.ID,
@@ -201,7 +247,7 @@ func (r *TaskRepository) Delete(ctx context.Context, id int64, tenantID int64) e
 	now := time.Now()
 	query := `UPDATE tasks SET deleted_at = $1 WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL`
 
-	result, err := r.db.ExecContext(ctx, query, now, id, tenantID)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, now, id, tenantID)
 	if err != nil {
 		return err
 	}
@@ -221,15 +267,18 @@ func (r *TaskRepository) Delete(ctx context.Context, id int64, tenantID int64) e
 func (r *TaskRepository) GetUpcomingTasks(ctx context.Context, tenantID int64, limit int, offset int) ([]domain.Task, error) {
 	query := `
 		SELECT id, title, description, points, status, scheduled_to, scheduled_by_id,
-		       frequency_value, frequency_unit, completed, completed_by_id,
+		       frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
 		       tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
 		FROM tasks
 		WHERE deleted_at IS NULL AND tenant_id = $1 AND completed = false
+			AND NOT EXISTS (
+				SELECT 1 FROM task_series s WHERE s.id = tasks.series_id AND s.paused_at IS NOT NULL
+			)
 		ORDER BY scheduled_to ASC NULLS FIRST
 		LIMIT $2 OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID, limit, offset)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -250,6 +299,14 @@ func (r *TaskRepository) GetUpcomingTasks(ctx context.Context, tenantID int64, l
 			&task.FrequencyUnit,
 			&task.Completed,
 			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
 			&task.TenantID,
 			&task.CreatedAt,
 			&task.CreatedById,
@@ -273,7 +330,7 @@ func (r *TaskRepository) GetUpcomingTasks(ctx context.Context, tenantID int64, l
 func (r *TaskRepository) GetCompletedTasksHistory(ctx context.Context, tenantID int64, limit int) ([]domain.TaskWithUser, error) {
 	query := `
 		SELECT t.id, t.title, t.description, t.points, t.status, t.scheduled_to, t.scheduled_by_id,
-		       t.frequency_value, t.frequency_unit, t.completed, t.completed_by_id,
+		       t.frequency_value, t.frequency_unit, t.completed, t.completed_by_id, t.assignee_id, t.rotation_id, t.series_id, t.previous_task_id, t.recurrence_rule, t.anchor_mode, t.overdue_at, t.completed_at,
 		       t.tenant_id, t.created_at, t.created_by_id, t.updated_at, t.updated_by_id, t.deleted_at,
 		       u.name as completed_by_name
 		FROM tasks t
@@ -283,7 +340,7 @@ func (r *TaskRepository) GetCompletedTasksHistory(ctx context.Context, tenantID
 		LIMIT $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID, limit)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -304,6 +361,14 @@ func (r *TaskRepository) GetCompletedTasksHistory(ctx context.Context, tenantID
 			&task.FrequencyUnit,
 			&task.Completed,
 			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
 			&task.TenantID,
 			&task.CreatedAt,
 			&task.CreatedById,
@@ -328,7 +393,7 @@ func (r *TaskRepository) GetCompletedTasksHistory(ctx context.Context, tenantID
 func (r *TaskRepository) GetCompletedTasksByUser(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error) {
 	query := `
 		SELECT t.id, t.title, t.description, t.points, t.status, t.scheduled_to, t.scheduled_by_id,
-		       t.frequency_value, t.frequency_unit, t.completed, t.completed_by_id,
+		       t.frequency_value, t.frequency_unit, t.completed, t.completed_by_id, t.assignee_id, t.rotation_id, t.series_id, t.previous_task_id, t.recurrence_rule, t.anchor_mode, t.overdue_at, t.completed_at,
 		       t.tenant_id, t.created_at, t.created_by_id, t.updated_at, t.updated_by_id, t.deleted_at,
 		       u.name as completed_by_name
 		FROM tasks t
@@ -338,7 +403,7 @@ func (r *TaskRepository) GetCompletedTasksByUser(ctx context.Context, userID int
 		LIMIT $3 OFFSET $4
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID, userID, limit, offset)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, userID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -359,6 +424,14 @@ func (r *TaskRepository) GetCompletedTasksByUser(ctx context.Context, userID int
 			&task.FrequencyUnit,
 			&task.Completed,
 			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
 			&task.TenantID,
 			&task.CreatedAt,
 			&task.CreatedById,
@@ -380,36 +453,141 @@ func (r *TaskRepository) GetCompletedTasksByUser(ctx context.Context, userID int
 	return tasks, nil
 }
 
-func (r *TaskRepository) FindTaskCreatedAfterCompletion(ctx context.Context, originalTask *domain.Task, completionTime time.Time) (*domain.Task, error) {
+func (r *TaskRepository) FetchAllByAssignee(ctx context.Context, tenantID int64, assigneeID int64) ([]domain.Task, error) {
 	query := `
 		SELECT id, title, description, points, status, scheduled_to, scheduled_by_id,
-		       frequency_value, frequency_unit, completed, completed_by_id,
+		       frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
 		       tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
 		FROM tasks
-		WHERE deleted_at IS NULL 
-			AND tenant_id = $1 
-			AND title = $2 
-			AND description = $3
-			AND completed = false
-			AND created_at >= $4
-			AND created_at <= $5
-		ORDER BY created_at ASC
-		LIMIT 1
+		WHERE deleted_at IS NULL AND tenant_id = $1 AND assignee_id = $2
+		ORDER BY created_at DESC
 	`
 
-	timeWindowStart := completionTime.Add(-time.Minute)
-	timeWindowEnd := completionTime.Add(time.Minute)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, assigneeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []domain.Task
+	for rows.Next() {
+		var task domain.Task
+		err := rows.Scan(
+			&task.ID,
+			&task.Title,
+			&task.Description,
+			&task.Points,
+			&task.Status,
+			&task.ScheduledTo,
+			&task.ScheduledById,
+			&task.FrequencyValue,
+			&task.FrequencyUnit,
+			&task.Completed,
+			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
+			&task.TenantID,
+			&task.CreatedAt,
+			&task.CreatedById,
+			&task.UpdatedAt,
+			&task.UpdatedById,
+			&task.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (r *TaskRepository) GetUpcomingTasksByAssignee(ctx context.Context, tenantID int64, assigneeID int64, limit int, offset int) ([]domain.Task, error) {
+	query := `
+		SELECT id, title, description, points, status, scheduled_to, scheduled_by_id,
+		       frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
+		       tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
+		FROM tasks
+		WHERE deleted_at IS NULL AND tenant_id = $1 AND assignee_id = $2 AND completed = false
+			AND NOT EXISTS (
+				SELECT 1 FROM task_series s WHERE s.id = tasks.series_id AND s.paused_at IS NOT NULL
+			)
+		ORDER BY scheduled_to ASC NULLS FIRST
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, assigneeID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []domain.Task
+	for rows.Next() {
+		var task domain.Task
+		err := rows.Scan(
+			&task.ID,
+			&task.Title,
+			&task.Description,
+			&task.Points,
+			&task.Status,
+			&task.ScheduledTo,
+			&task.ScheduledById,
+			&task.FrequencyValue,
+			&task.FrequencyUnit,
+			&task.Completed,
+			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
+			&task.TenantID,
+			&task.CreatedAt,
+			&task.CreatedById,
+			&task.UpdatedAt,
+			&task.UpdatedById,
+			&task.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (r *TaskRepository) FindNextOccurrence(ctx context.Context, taskID int64, tenantID int64) (*domain.Task, error) {
+	query := `
+		SELECT id, title, description, points, status, scheduled_to, scheduled_by_id,
+		       frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
+		       tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
+		FROM tasks
+		WHERE previous_task_id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+		LIMIT 1
+	`
 
 	var task domain.Task
-	err := r.db.QueryRowContext(
-		ctx,
-		query,
-		originalTask.TenantID,
-		originalTask.Title,
-		originalTask.Description,
-		timeWindowStart,
-		timeWindowEnd,
-	).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, taskID, tenantID).Scan(
 		&task.ID,
 		&task.Title,
 		&task.Description,
@@ -421,6 +599,14 @@ func (r *TaskRepository) FindTaskCreatedAfterCompletion(ctx context.Context, ori
 		&task.FrequencyUnit,
 		&task.Completed,
 		&task.CompletedById,
+		&task.AssigneeID,
+		&task.RotationID,
+		&task.SeriesID,
+		&task.PreviousTaskID,
+		&task.RecurrenceRule,
+		&task.AnchorMode,
+		&task.OverdueAt,
+		&task.CompletedAt,
 		&task.TenantID,
 		&task.CreatedAt,
 		&task.CreatedById,
@@ -438,3 +624,198 @@ func (r *TaskRepository) FindTaskCreatedAfterCompletion(ctx context.Context, ori
 
 	return &task, nil
 }
+
+func (r *TaskRepository) GetPendingBySeries(ctx context.Context, seriesID int64, tenantID int64) ([]domain.Task, error) {
+	query := `
+		SELECT id, title, description, points, status, scheduled_to, scheduled_by_id,
+		       frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
+		       tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
+		FROM tasks
+		WHERE series_id = $1 AND tenant_id = $2 AND completed = false AND deleted_at IS NULL
+		ORDER BY scheduled_to ASC NULLS FIRST
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, seriesID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []domain.Task
+	for rows.Next() {
+		var task domain.Task
+		err := rows.Scan(
+			&task.ID,
+			&task.Title,
+			&task.Description,
+			&task.Points,
+			&task.Status,
+			&task.ScheduledTo,
+			&task.ScheduledById,
+			&task.FrequencyValue,
+			&task.FrequencyUnit,
+			&task.Completed,
+			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
+			&task.TenantID,
+			&task.CreatedAt,
+			&task.CreatedById,
+			&task.UpdatedAt,
+			&task.UpdatedById,
+			&task.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (r *TaskRepository) GetSeriesHistory(ctx context.Context, seriesID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error) {
+	query := `
+		SELECT t.id, t.title, t.description, t.points, t.status, t.scheduled_to, t.scheduled_by_id,
+		       t.frequency_value, t.frequency_unit, t.completed, t.completed_by_id, t.assignee_id, t.rotation_id, t.series_id, t.previous_task_id, t.recurrence_rule, t.anchor_mode, t.overdue_at, t.completed_at,
+		       t.tenant_id, t.created_at, t.created_by_id, t.updated_at, t.updated_by_id, t.deleted_at,
+		       u.name as completed_by_name
+		FROM tasks t
+		LEFT JOIN users u ON t.completed_by_id = u.id AND u.deleted_at IS NULL
+		WHERE t.deleted_at IS NULL AND t.tenant_id = $1 AND t.series_id = $2 AND t.completed = true
+		ORDER BY t.updated_at DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, seriesID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []domain.TaskWithUser
+	for rows.Next() {
+		var task domain.TaskWithUser
+		err := rows.Scan(
+			&task.ID,
+			&task.Title,
+			&task.Description,
+			&task.Points,
+			&task.Status,
+			&task.ScheduledTo,
+			&task.ScheduledById,
+			&task.FrequencyValue,
+			&task.FrequencyUnit,
+			&task.Completed,
+			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
+			&task.TenantID,
+			&task.CreatedAt,
+			&task.CreatedById,
+			&task.UpdatedAt,
+			&task.UpdatedById,
+			&task.DeletedAt,
+			&task.CompletedByName,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (r *TaskRepository) MarkOverdue(ctx context.Context, now time.Time) ([]domain.Task, error) {
+	query := `
+		UPDATE tasks SET overdue_at = $1
+		WHERE deleted_at IS NULL AND completed = false AND overdue_at IS NULL AND scheduled_to < $1
+			AND NOT EXISTS (
+				SELECT 1 FROM task_series s WHERE s.id = tasks.series_id AND s.paused_at IS NOT NULL
+			)
+		RETURNING id, title, description, points, status, scheduled_to, scheduled_by_id,
+		          frequency_value, frequency_unit, completed, completed_by_id, assignee_id, rotation_id, series_id, previous_task_id, recurrence_rule, anchor_mode, overdue_at, completed_at,
+		          tenant_id, created_at, created_by_id, updated_at, updated_by_id, deleted_at
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []domain.Task
+	for rows.Next() {
+		var task domain.Task
+		err := rows.Scan(
+			&task.ID,
+			&task.Title,
+			&task.Description,
+			&task.Points,
+			&task.Status,
+			&task.ScheduledTo,
+			&task.ScheduledById,
+			&task.FrequencyValue,
+			&task.FrequencyUnit,
+			&task.Completed,
+			&task.CompletedById,
+			&task.AssigneeID,
+			&task.RotationID,
+			&task.SeriesID,
+			&task.PreviousTaskID,
+			&task.RecurrenceRule,
+			&task.AnchorMode,
+			&task.OverdueAt,
+			&task.CompletedAt,
+			&task.TenantID,
+			&task.CreatedAt,
+			&task.CreatedById,
+			&task.UpdatedAt,
+			&task.UpdatedById,
+			&task.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (r *TaskRepository) UnassignPending(ctx context.Context, tenantID int64, assigneeID int64, from time.Time, to *time.Time, now time.Time) error {
+	query := `
+		UPDATE tasks SET assignee_id = NULL, updated_at = $5
+		WHERE tenant_id = $1 AND assignee_id = $2 AND completed = false AND deleted_at IS NULL
+			AND scheduled_to >= $3
+			AND ($4::timestamp IS NULL OR scheduled_to < $4)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, tenantID, assigneeID, from, to, now)
+	return err
+}
@@ -0,0 +1,136 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type TaskRotationRepository struct {
+	db *sql.DB
+}
+
+func NewTaskRotationRepository(db *sql.DB) domain.TaskRotationRepository {
+	return &TaskRotationRepository{db: db}
+}
+
+func (r *TaskRotationRepository) Create(ctx context.Context, rotation *domain.TaskRotation) error {
+	query := `
+		INSERT INTO task_rotations (tenant_id, strategy, member_ids, last_assignee_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		rotation.TenantID,
+		rotation.Strategy,
+		pq.Array(rotation.MemberIDs),
+		rotation.LastAssigneeID,
+		rotation.CreatedAt,
+		rotation.UpdatedAt,
+	).Scan(&rotation.ID)
+}
+
+func (r *TaskRotationRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.TaskRotation, error) {
+	query := `
+		SELECT id, tenant_id, strategy, member_ids, last_assignee_id, created_at, updated_at
+		FROM task_rotations
+		WHERE id = $1 AND tenant_id = $2
+	`
+
+	var rotation domain.TaskRotation
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
+		&rotation.ID,
+		&rotation.TenantID,
+		&rotation.Strategy,
+		pq.Array(&rotation.MemberIDs),
+		&rotation.LastAssigneeID,
+		&rotation.CreatedAt,
+		&rotation.UpdatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &rotation, nil
+}
+
+func (r *TaskRotationRepository) Update(ctx context.Context, rotation *domain.TaskRotation) error {
+	query := `
+		UPDATE task_rotations SET
+			strategy = $1,
+			member_ids = $2,
+			last_assignee_id = $3,
+			updated_at = $4
+		WHERE id = $5 AND tenant_id = $6
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		rotation.Strategy,
+		pq.Array(rotation.MemberIDs),
+		rotation.LastAssigneeID,
+		rotation.UpdatedAt,
+		rotation.ID,
+		rotation.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *TaskRotationRepository) GetLastCompletions(ctx context.Context, rotationID int64, tenantID int64) (map[int64]time.Time, error) {
+	query := `
+		SELECT completed_by_id, MAX(updated_at)
+		FROM tasks
+		WHERE rotation_id = $1
+			AND tenant_id = $2
+			AND completed = true
+			AND completed_by_id IS NOT NULL
+			AND deleted_at IS NULL
+		GROUP BY completed_by_id
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, rotationID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	completions := make(map[int64]time.Time)
+	for rows.Next() {
+		var userID int64
+		var completedAt time.Time
+		if err := rows.Scan(&userID, &completedAt); err != nil {
+			return nil, err
+		}
+		completions[userID] = completedAt
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return completions, nil
+}
@@ -0,0 +1,203 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type TaskSeriesRepository struct {
+	db *sql.DB
+}
+
+func NewTaskSeriesRepository(db *sql.DB) domain.TaskSeriesRepository {
+	return &TaskSeriesRepository{db: db}
+}
+
+func (r *TaskSeriesRepository) Create(ctx context.Context, series *domain.TaskSeries) error {
+	query := `
+		INSERT INTO task_series (
+			tenant_id, title, description, points, frequency_value, frequency_unit,
+			recurrence_rule, starts_at, anchor_mode, paused_at, created_at, created_by_id, updated_at, updated_by_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		series.TenantID,
+		series.Title,
+		series.Description,
+		series.Points,
+		series.FrequencyValue,
+		series.FrequencyUnit,
+		series.RecurrenceRule,
+		series.StartsAt,
+		series.AnchorMode,
+		series.PausedAt,
+		series.CreatedAt,
+		series.CreatedById,
+		series.UpdatedAt,
+		series.UpdatedById,
+	).Scan(&series.ID)
+}
+
+func (r *TaskSeriesRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.TaskSeries, error) {
+	query := `
+		SELECT id, tenant_id, title, description, points, frequency_value, frequency_unit,
+		       recurrence_rule, starts_at, anchor_mode, paused_at, created_at, created_by_id, updated_at, updated_by_id, deleted_at
+		FROM task_series
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY title ASC
+	`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seriesList []domain.TaskSeries
+	for rows.Next() {
+		var series domain.TaskSeries
+		err := rows.Scan(
+			&series.ID,
+			&series.TenantID,
+			&series.Title,
+			&series.Description,
+			&series.Points,
+			&series.FrequencyValue,
+			&series.FrequencyUnit,
+			&series.RecurrenceRule,
+			&series.StartsAt,
+			&series.AnchorMode,
+			&series.PausedAt,
+			&series.CreatedAt,
+			&series.CreatedById,
+			&series.UpdatedAt,
+			&series.UpdatedById,
+			&series.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		seriesList = append(seriesList, series)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return seriesList, nil
+}
+
+func (r *TaskSeriesRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.TaskSeries, error) {
+	query := `
+		SELECT id, tenant_id, title, description, points, frequency_value, frequency_unit,
+		       recurrence_rule, starts_at, anchor_mode, paused_at, created_at, created_by_id, updated_at, updated_by_id, deleted_at
+		FROM task_series
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+	`
+
+	var series domain.TaskSeries
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
+		&series.ID,
+		&series.TenantID,
+		&series.Title,
+		&series.Description,
+		&series.Points,
+		&series.FrequencyValue,
+		&series.FrequencyUnit,
+		&series.RecurrenceRule,
+		&series.StartsAt,
+		&series.AnchorMode,
+		&series.PausedAt,
+		&series.CreatedAt,
+		&series.CreatedById,
+		&series.UpdatedAt,
+		&series.UpdatedById,
+		&series.DeletedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &series, nil
+}
+
+func (r *TaskSeriesRepository) Update(ctx context.Context, series *domain.TaskSeries) error {
+	query := `
+		UPDATE task_series SET
+			title = $1,
+			description = $2,
+			points = $3,
+			frequency_value = $4,
+			frequency_unit = $5,
+			recurrence_rule = $6,
+			starts_at = $7,
+			anchor_mode = $8,
+			paused_at = $9,
+			updated_at = $10,
+			updated_by_id = $11
+		WHERE id = $12 AND tenant_id = $13 AND deleted_at IS NULL
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		series.Title,
+		series.Description,
+		series.Points,
+		series.FrequencyValue,
+		series.FrequencyUnit,
+		series.RecurrenceRule,
+		series.StartsAt,
+		series.AnchorMode,
+		series.PausedAt,
+		series.UpdatedAt,
+		series.UpdatedById,
+		series.ID,
+		series.TenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *TaskSeriesRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	now := time.Now()
+	query := `UPDATE task_series SET deleted_at = $1 WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, now, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -22,7 +22,7 @@ func (r *TenantRepository) Create(ctx context.Context, tenant *domain.Tenant) er
 		RETURNING id
 	`
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		tenant.Name,
@@ -47,7 +47,7 @@ func (r *TenantRepository) GetByID(ctx context.Context, id int64) (*domain.Tenan
 	`
 
 	var tenant domain.Tenant
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&tenant.ID,
 		&tenant.Name,
 		&tenant.Domain,
@@ -75,7 +75,7 @@ func (r *TenantRepository) GetByDomain(ctx context.Context, domainParam string)
 	`
 
 	var tenant domain.Tenant
-	err := r.db.QueryRowContext(ctx, query, domainParam).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, domainParam).Scan(
 		&tenant.ID,
 		&tenant.Name,
 		&tenant.Domain,
@@ -103,7 +103,7 @@ func (r *TenantRepository) FetchAll(ctx context.Context) ([]domain.Tenant, error
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +144,7 @@ func (r *TenantRepository) Update(ctx context.Context, tenant *domain.Tenant) er
 		WHERE id = $5 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(
+	result, err := conn(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		tenant.Name,
@@ -174,7 +174,7 @@ func (r *TenantRepository) Delete(ctx context.Context, id int64) error {
 	now := time.Now()
 	query := `UPDATE tenants SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL`
 
-	result, err := r.db.ExecContext(ctx, query, now, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, now, id)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,141 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type TokenRepository struct {
+	db *sql.DB
+}
+
+func NewTokenRepository(db *sql.DB) domain.TokenRepository {
+	return &TokenRepository{db: db}
+}
+
+func (r *TokenRepository) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (user_id, tenant_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	return conn(ctx, r.db).QueryRowContext(
+		ctx,
+		query,
+		token.UserID,
+		token.TenantID,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	).Scan(&token.ID)
+}
+
+func (r *TokenRepository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	query := `
+		SELECT id, user_id, tenant_id, token_hash, expires_at, revoked_at, replaced_by_id, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	var token domain.RefreshToken
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TenantID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.RevokedAt,
+		&token.ReplacedByID,
+		&token.CreatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *TokenRepository) RevokeRefreshToken(ctx context.Context, id int64, replacedByID *int64) error {
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = $1, replaced_by_id = $2
+		WHERE id = $3 AND revoked_at IS NULL
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, time.Now(), replacedByID, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *TokenRepository) RevokeAccessToken(ctx context.Context, jti string, userID int64, expiresAt time.Time) error {
+	query := `
+		INSERT INTO revoked_tokens (jti, user_id, expires_at, revoked_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (jti) DO NOTHING
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, jti, userID, expiresAt, time.Now())
+	return err
+}
+
+func (r *TokenRepository) RevokeAllUserTokens(ctx context.Context, userID int64) error {
+	now := time.Now()
+
+	return NewTransactor(r.db).WithinTransaction(ctx, func(ctx context.Context) error {
+		if _, err := conn(ctx, r.db).ExecContext(
+			ctx,
+			`UPDATE refresh_tokens SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL`,
+			now,
+			userID,
+		); err != nil {
+			return err
+		}
+
+		_, err := conn(ctx, r.db).ExecContext(
+			ctx,
+			`UPDATE users SET tokens_revoked_at = $1 WHERE id = $2`,
+			now,
+			userID,
+		)
+		return err
+	})
+}
+
+func (r *TokenRepository) IsAccessTokenRevoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)
+		    OR NOT EXISTS (
+		        SELECT 1 FROM users
+		        WHERE id = $2
+		          AND deleted_at IS NULL
+		          AND status = 'active'
+		          AND (tokens_revoked_at IS NULL OR tokens_revoked_at <= $3)
+		    )
+	`
+
+	var revoked bool
+	if err := conn(ctx, r.db).QueryRowContext(ctx, query, jti, userID, issuedAt).Scan(&revoked); err != nil {
+		return false, err
+	}
+
+	return revoked, nil
+}
@@ -24,7 +24,7 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 		RETURNING id
 	`
 
-	err := r.db.QueryRowContext(
+	err := conn(ctx, r.db).QueryRowContext(
 		ctx,
 		query,
 		user.Name,
@@ -46,16 +46,24 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
-func (r *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
+func (r *UserRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	return r.getByID(ctx, id, tenantID, "")
+}
+
+func (r *UserRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	return r.getByID(ctx, id, tenantID, "FOR UPDATE")
+}
+
+func (r *UserRepository) getByID(ctx context.Context, id int64, tenantID int64, lock string) (*domain.User, error) {
 	query := `
 		SELECT id, name, email, password, tenant_id, points, role, status,
 		       last_login_at, created_at, updated_at, deleted_at
 		FROM users
-		WHERE id = $1 AND deleted_at IS NULL
-	`
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+	` + lock
 
 	var user domain.User
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, tenantID).Scan(
 		&user.ID,
 		&user.Name,
 		&user.Email,
@@ -85,11 +93,11 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.
 		SELECT id, name, email, password, tenant_id, points, role, status,
 		       last_login_at, created_at, updated_at, deleted_at
 		FROM users
-		WHERE email = $1 AND deleted_at IS NULL
+		WHERE lower(email) = lower($1) AND deleted_at IS NULL
 	`
 
 	var user domain.User
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, email).Scan(
 		&user.ID,
 		&user.Name,
 		&user.Email,
@@ -119,11 +127,11 @@ func (r *UserRepository) GetByEmailAndTenant(ctx context.Context, email string,
 		SELECT id, name, email, password, tenant_id, points, role, status,
 		       last_login_at, created_at, updated_at, deleted_at
 		FROM users
-		WHERE email = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		WHERE lower(email) = lower($1) AND tenant_id = $2 AND deleted_at IS NULL
 	`
 
 	var user domain.User
-	err := r.db.QueryRowContext(ctx, query, email, tenantID).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, email, tenantID).Scan(
 		&user.ID,
 		&user.Name,
 		&user.Email,
@@ -157,7 +165,7 @@ func (r *UserRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -203,7 +211,7 @@ func (r *UserRepository) GetTopUsersByPoints(ctx context.Context, tenantID int64
 		LIMIT $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, tenantID, limit)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, tenantID, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -245,26 +253,25 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 			name = $1,
 			email = $2,
 			password = $3,
-			points = $4,
-			role = $5,
-			status = $6,
-			last_login_at = $7,
-			updated_at = $8
-		WHERE id = $9 AND deleted_at IS NULL
+			role = $4,
+			status = $5,
+			last_login_at = $6,
+			updated_at = $7
+		WHERE id = $8 AND tenant_id = $9 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(
+	result, err := conn(ctx, r.db).ExecContext(
 		ctx,
 		query,
 		user.Name,
 		user.Email,
 		user.Password,
-		user.Points,
 		user.Role,
 		user.Status,
 		user.LastLoginAt,
 		user.UpdatedAt,
 		user.ID,
+		user.TenantID,
 	)
 
 	if err != nil {
@@ -283,11 +290,11 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
-func (r *UserRepository) Delete(ctx context.Context, id int64) error {
+func (r *UserRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
 	now := time.Now()
-	query := `UPDATE users SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL`
+	query := `UPDATE users SET deleted_at = $1 WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL`
 
-	result, err := r.db.ExecContext(ctx, query, now, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, now, id, tenantID)
 	if err != nil {
 		return err
 	}
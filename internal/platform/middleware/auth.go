@@ -5,8 +5,10 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"keep-your-house-clean/internal/domain"
 )
 
 type key int
@@ -14,15 +16,22 @@ type key int
 const (
 	userIDKey key = 0
 	tenantIDKey key = 1
+	roleKey key = 2
+	tokenIDKey key = 3
 )
 
 type Claims struct {
-	UserID   int64 `json:"user_id"`
-	TenantID int64 `json:"tenant_id"`
+	UserID   int64  `json:"user_id"`
+	TenantID int64  `json:"tenant_id"`
+	Role     string `json:"role"`
 	jwt.RegisteredClaims
 }
 
-func JWTAuthMiddleware(secretKey string) func(http.Handler) http.Handler {
+type TokenRevocationChecker interface {
+	IsAccessTokenRevoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error)
+}
+
+func JWTAuthMiddleware(secretKey string, revocations TokenRevocationChecker) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
@@ -37,54 +46,57 @@ func JWTAuthMiddleware(secretKey string) func(http.Handler) http.Handler {
 				return
 			}
 
-			tokenString := parts[1]
-			token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, errors.New("unexpected signing method")
-				}
-				return []byte(secretKey), nil
-			})
-
+			claims, err := ParseToken(parts[1], secretKey)
 			if err != nil {
 				respondWithError(w, http.StatusUnauthorized, "Invalid token")
 				return
 			}
 
-			claims, ok := token.Claims.(*Claims)
-			if !ok {
-				mapClaims, ok := token.Claims.(jwt.MapClaims)
-				if !ok || !token.Valid {
-					respondWithError(w, http.StatusUnauthorized, "Invalid token claims")
-					return
-				}
-				userIDFloat, ok := mapClaims["user_id"].(float64)
-				if !ok {
-					respondWithError(w, http.StatusUnauthorized, "Invalid token claims")
+			if claims.ID == "" || claims.IssuedAt == nil {
+				respondWithError(w, http.StatusUnauthorized, "Invalid token claims")
+				return
+			}
+
+			if revocations != nil {
+				revoked, err := revocations.IsAccessTokenRevoked(r.Context(), claims.ID, claims.UserID, claims.IssuedAt.Time)
+				if err != nil {
+					respondWithError(w, http.StatusInternalServerError, "Failed to validate token")
 					return
 				}
-				tenantIDFloat, ok := mapClaims["tenant_id"].(float64)
-				if !ok {
-					respondWithError(w, http.StatusUnauthorized, "Invalid token claims")
+				if revoked {
+					respondWithError(w, http.StatusUnauthorized, "Token has been revoked")
 					return
 				}
-				ctx := context.WithValue(r.Context(), userIDKey, int64(userIDFloat))
-				ctx = context.WithValue(ctx, tenantIDKey, int64(tenantIDFloat))
-				next.ServeHTTP(w, r.WithContext(ctx))
-				return
-			}
-
-			if !token.Valid {
-				respondWithError(w, http.StatusUnauthorized, "Invalid token claims")
-				return
 			}
 
 			ctx := context.WithValue(r.Context(), userIDKey, claims.UserID)
 			ctx = context.WithValue(ctx, tenantIDKey, claims.TenantID)
+			ctx = context.WithValue(ctx, roleKey, roleOrDefault(claims.Role))
+			ctx = context.WithValue(ctx, tokenIDKey, claims.ID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+func ParseToken(tokenString string, secretKey string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(secretKey), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*Claims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token claims")
+	}
+
+	return claims, nil
+}
+
 func GetUserIDFromContext(ctx context.Context) int64 {
 	userID, ok := ctx.Value(userIDKey).(int64)
 	if !ok {
@@ -109,6 +121,37 @@ func SetTenantIDInContext(ctx context.Context, tenantID int64) context.Context {
 	return context.WithValue(ctx, tenantIDKey, tenantID)
 }
 
+func GetRoleFromContext(ctx context.Context) string {
+	role, ok := ctx.Value(roleKey).(string)
+	if !ok {
+		return ""
+	}
+	return role
+}
+
+func SetRoleInContext(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, roleKey, role)
+}
+
+func GetTokenIDFromContext(ctx context.Context) string {
+	tokenID, ok := ctx.Value(tokenIDKey).(string)
+	if !ok {
+		return ""
+	}
+	return tokenID
+}
+
+func SetTokenIDInContext(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, tokenIDKey, tokenID)
+}
+
+func roleOrDefault(role string) string {
+	if role == "" {
+		return domain.RoleUser
+	}
+	return role
+}
+
 func respondWithError(w http.ResponseWriter, statusCode int, message string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
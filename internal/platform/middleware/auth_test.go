@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-secret"
+
+type fakeRevocationChecker struct {
+	revoked  bool
+	err      error
+	jti      string
+	userID   int64
+	issuedAt time.Time
+}
+
+func (f *fakeRevocationChecker) IsAccessTokenRevoked(ctx context.Context, jti string, userID int64, issuedAt time.Time) (bool, error) {
+	f.jti = jti
+	f.userID = userID
+	f.issuedAt = issuedAt
+	return f.revoked, f.err
+}
+
+func signTestToken(t *testing.T, jti string, issuedAt time.Time) string {
+	t.Helper()
+
+	claims := Claims{
+		UserID:   7,
+		TenantID: 3,
+		Role:     "user",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(time.Hour)),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("erro ao assinar token: %v", err)
+	}
+	return token
+}
+
+func TestJWTAuthMiddleware_Revocation(t *testing.T) {
+	issuedAt := time.Now().Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		jti            string
+		checker        *fakeRevocationChecker
+		expectedStatus int
+	}{
+		{
+			name:           "token válido passa",
+			jti:            "jti-1",
+			checker:        &fakeRevocationChecker{},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "token revogado é rejeitado",
+			jti:            "jti-1",
+			checker:        &fakeRevocationChecker{revoked: true},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "erro ao consultar revogação",
+			jti:            "jti-1",
+			checker:        &fakeRevocationChecker{err: errors.New("database error")},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "token sem jti é rejeitado",
+			checker:        &fakeRevocationChecker{},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotUserID int64
+			var gotTokenID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUserID = GetUserIDFromContext(r.Context())
+				gotTokenID = GetTokenIDFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks", nil)
+			req.Header.Set("Authorization", "Bearer "+signTestToken(t, tt.jti, issuedAt))
+			rec := httptest.NewRecorder()
+
+			JWTAuthMiddleware(testSecret, tt.checker)(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("status esperado %d, obtido %d", tt.expectedStatus, rec.Code)
+			}
+
+			if tt.jti == "" {
+				return
+			}
+			if tt.checker.jti != tt.jti || tt.checker.userID != 7 || !tt.checker.issuedAt.Equal(issuedAt) {
+				t.Errorf("consulta de revogação com argumentos inesperados: %+v", tt.checker)
+			}
+			if tt.expectedStatus == http.StatusOK && (gotUserID != 7 || gotTokenID != tt.jti) {
+				t.Errorf("contexto esperado user 7 e jti %s, obtido user %d e jti %s", tt.jti, gotUserID, gotTokenID)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"keep-your-house-clean/internal/domain"
+)
+
+type Permission string
+
+const (
+	PermissionManageUsers           Permission = "users:manage"
+	PermissionManageTenants         Permission = "tenants:manage"
+	PermissionManageOwnTenant       Permission = "tenant:manage"
+	PermissionManageTasks           Permission = "tasks:manage"
+	PermissionCompleteTaskForOthers Permission = "tasks:complete_for_others"
+	PermissionManageCompliments     Permission = "compliments:manage"
+	PermissionManageRewards         Permission = "rewards:manage"
+	PermissionManageAchievements    Permission = "achievements:manage"
+)
+
+var rolePermissions = map[string][]Permission{
+	domain.RoleSuperAdmin: {
+		PermissionManageUsers,
+		PermissionManageTenants,
+		PermissionManageOwnTenant,
+		PermissionManageTasks,
+		PermissionCompleteTaskForOthers,
+		PermissionManageCompliments,
+		PermissionManageRewards,
+		PermissionManageAchievements,
+	},
+	domain.RoleAdmin: {
+		PermissionManageUsers,
+		PermissionManageOwnTenant,
+		PermissionManageTasks,
+		PermissionCompleteTaskForOthers,
+		PermissionManageCompliments,
+		PermissionManageRewards,
+		PermissionManageAchievements,
+	},
+	domain.RoleUser: {},
+}
+
+type ForbiddenError struct {
+	Role       string
+	Permission Permission
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("role %s is not allowed to perform %s", e.Role, e.Permission)
+}
+
+func (e *ForbiddenError) HTTPCode() int {
+	return http.StatusForbidden
+}
+
+func IsForbidden(err error) bool {
+	var forbiddenErr *ForbiddenError
+	return errors.As(err, &forbiddenErr)
+}
+
+func HasPermission(role string, permission Permission) bool {
+	for _, p := range rolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+func Authorize(ctx context.Context, permission Permission) error {
+	role := GetRoleFromContext(ctx)
+	if !HasPermission(role, permission) {
+		return &ForbiddenError{Role: role, Permission: permission}
+	}
+	return nil
+}
+
+func IsAdmin(ctx context.Context) bool {
+	role := GetRoleFromContext(ctx)
+	return role == domain.RoleAdmin || role == domain.RoleSuperAdmin
+}
+
+func RequirePermission(permission Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := Authorize(r.Context(), permission); err != nil {
+				respondWithError(w, http.StatusForbidden, err.Error())
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			role := GetRoleFromContext(r.Context())
+			for _, allowed := range roles {
+				if role == allowed {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+			respondWithError(w, http.StatusForbidden, "insufficient role")
+		})
+	}
+}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS task_rotations (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE RESTRICT,
+    strategy VARCHAR(50) NOT NULL CHECK (strategy IN ('round_robin', 'fewest_points', 'least_recently_completed')),
+    member_ids BIGINT[] NOT NULL,
+    last_assignee_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_rotations_tenant_id ON task_rotations(tenant_id);
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS rotation_id BIGINT REFERENCES task_rotations(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_rotation_id ON tasks(rotation_id);
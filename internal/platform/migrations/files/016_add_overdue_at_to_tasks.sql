@@ -0,0 +1,3 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS overdue_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_pending_schedule ON tasks(scheduled_to) WHERE completed = false AND overdue_at IS NULL AND deleted_at IS NULL;
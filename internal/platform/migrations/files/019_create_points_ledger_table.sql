@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS points_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE RESTRICT,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    delta INTEGER NOT NULL,
+    reason VARCHAR(50) NOT NULL,
+    source_type VARCHAR(50) NOT NULL,
+    source_id BIGINT NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_points_ledger_tenant_user ON points_ledger(tenant_id, user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_points_ledger_tenant_created ON points_ledger(tenant_id, created_at);
+
+INSERT INTO points_ledger (tenant_id, user_id, delta, reason, source_type, source_id, idempotency_key, created_at)
+SELECT tenant_id, id, points, 'opening_balance', 'user', id, 'opening_balance:' || id, NOW()
+FROM users
+WHERE points <> 0
+ON CONFLICT (idempotency_key) DO NOTHING;
@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS achievement_definitions (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT REFERENCES tenants(id) ON DELETE CASCADE,
+    code VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    metric VARCHAR(50) NOT NULL CHECK (metric IN ('tasks_completed', 'completion_streak', 'compliments_sent', 'compliments_received', 'overdue_completed')),
+    threshold INTEGER NOT NULL CHECK (threshold > 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_achievement_definitions_code ON achievement_definitions(COALESCE(tenant_id, 0), code);
+
+CREATE TABLE IF NOT EXISTS achievement_progress (
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    metric VARCHAR(50) NOT NULL,
+    value INTEGER NOT NULL DEFAULT 0,
+    last_activity_on DATE,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, metric)
+);
+
+CREATE TABLE IF NOT EXISTS achievement_processed_events (
+    event_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    processed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS user_achievements (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    achievement_id BIGINT NOT NULL REFERENCES achievement_definitions(id) ON DELETE CASCADE,
+    awarded_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, achievement_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_achievements_tenant_user ON user_achievements(tenant_id, user_id);
+
+INSERT INTO achievement_definitions (tenant_id, code, name, description, metric, threshold)
+VALUES
+    (NULL, 'ten_tasks', 'Getting Things Done', 'Complete 10 tasks', 'tasks_completed', 10),
+    (NULL, 'week_streak', 'On a Roll', 'Complete at least one task for 7 days in a row', 'completion_streak', 7),
+    (NULL, 'first_compliment', 'Kind Words', 'Send your first compliment', 'compliments_sent', 1),
+    (NULL, 'overdue_rescuer', 'Better Late Than Never', 'Complete 5 overdue chores', 'overdue_completed', 5)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,27 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"keep-your-house-clean/internal/platform/mail"
+)
+
+type EmailNotifier struct {
+	mailer mail.Mailer
+}
+
+func NewEmailNotifier(mailer mail.Mailer) *EmailNotifier {
+	return &EmailNotifier{mailer: mailer}
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, message Message) error {
+	if message.Email == "" {
+		return errors.New("recipient has no email address")
+	}
+
+	return n.mailer.Send(ctx, mail.Message{
+		To:      message.Email,
+		Subject: message.Title,
+		Body:    message.Body,
+	})
+}
@@ -0,0 +1,27 @@
+package notifier
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type InAppNotifier struct {
+	repo domain.NotificationRepository
+}
+
+func NewInAppNotifier(repo domain.NotificationRepository) *InAppNotifier {
+	return &InAppNotifier{repo: repo}
+}
+
+func (n *InAppNotifier) Notify(ctx context.Context, message Message) error {
+	return n.repo.Create(ctx, &domain.Notification{
+		TenantID:  message.TenantID,
+		UserID:    message.UserID,
+		Type:      message.Type,
+		Title:     message.Title,
+		Body:      message.Body,
+		TaskID:    message.TaskID,
+		CreatedAt: time.Now(),
+	})
+}
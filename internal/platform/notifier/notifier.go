@@ -0,0 +1,18 @@
+package notifier
+
+import "context"
+
+type Message struct {
+	TenantID   int64
+	UserID     int64
+	Email      string
+	WebhookURL string
+	Type       string
+	Title      string
+	Body       string
+	TaskID     *int64
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
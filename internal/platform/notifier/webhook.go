@@ -0,0 +1,67 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+func NewWebhookNotifier(client *http.Client) *WebhookNotifier {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookNotifier{client: client}
+}
+
+type webhookPayload struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Body     string `json:"body"`
+	TaskID   *int64 `json:"task_id"`
+	UserID   int64  `json:"user_id"`
+	TenantID int64  `json:"tenant_id"`
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, message Message) error {
+	if message.WebhookURL == "" {
+		return errors.New("webhook url not defined")
+	}
+
+	body, err := json.Marshal(webhookPayload{
+		Type:     message.Type,
+		Title:    message.Title,
+		Body:     message.Body,
+		TaskID:   message.TaskID,
+		UserID:   message.UserID,
+		TenantID: message.TenantID,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, message.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"context"
+	"hash/fnv"
+	"log"
+	"sync"
+	"time"
+)
+
+type JobFunc func(ctx context.Context) error
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Timeout  time.Duration
+	Run      JobFunc
+}
+
+type Locker interface {
+	TryLock(ctx context.Context, key int64) (release func(), acquired bool, err error)
+}
+
+type Scheduler struct {
+	locker Locker
+	jobs   []Job
+	mu     sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(ctx context.Context, locker Locker) *Scheduler {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Scheduler{
+		locker: locker,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (s *Scheduler) Register(job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			log.Printf("Warning: job %s has no interval, skipping", job.Name)
+			continue
+		}
+
+		s.wg.Add(1)
+		go s.loop(job)
+	}
+}
+
+func (s *Scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	s.RunOnce(job)
+	for {
+		select {
+		case <-ticker.C:
+			s.RunOnce(job)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Scheduler) RunOnce(job Job) bool {
+	release, acquired, err := s.locker.TryLock(s.ctx, lockKey(job.Name))
+	if err != nil {
+		log.Printf("Error acquiring lock for job %s: %v", job.Name, err)
+		return false
+	}
+	if !acquired {
+		return false
+	}
+	defer release()
+
+	ctx := s.ctx
+	if job.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, job.Timeout)
+		defer cancel()
+	}
+
+	if err := job.Run(ctx); err != nil {
+		log.Printf("Error running job %s: %v", job.Name, err)
+	}
+	return true
+}
+
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("scheduler:" + name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeLocker struct {
+	acquired bool
+	err      error
+	released int
+	keys     []int64
+}
+
+func (l *fakeLocker) TryLock(ctx context.Context, key int64) (func(), bool, error) {
+	l.keys = append(l.keys, key)
+	if l.err != nil || !l.acquired {
+		return nil, false, l.err
+	}
+	return func() { l.released++ }, true, nil
+}
+
+func TestScheduler_RunOnce(t *testing.T) {
+	tests := []struct {
+		name         string
+		locker       *fakeLocker
+		jobErr       error
+		expectedRun  bool
+		expectedRels int
+	}{
+		{
+			name:         "executa o job quando obtém o lock",
+			locker:       &fakeLocker{acquired: true},
+			expectedRun:  true,
+			expectedRels: 1,
+		},
+		{
+			name:         "libera o lock mesmo quando o job falha",
+			locker:       &fakeLocker{acquired: true},
+			jobErr:       errors.New("job error"),
+			expectedRun:  true,
+			expectedRels: 1,
+		},
+		{
+			name:        "não executa quando outra instância tem o lock",
+			locker:      &fakeLocker{acquired: false},
+			expectedRun: false,
+		},
+		{
+			name:        "não executa quando o lock falha",
+			locker:      &fakeLocker{err: errors.New("connection error")},
+			expectedRun: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScheduler(context.Background(), tt.locker)
+			defer s.Stop()
+
+			ran := false
+			job := Job{
+				Name: "test",
+				Run: func(ctx context.Context) error {
+					ran = true
+					return tt.jobErr
+				},
+			}
+
+			executed := s.RunOnce(job)
+			if executed != tt.expectedRun || ran != tt.expectedRun {
+				t.Errorf("execução esperada %v, obtida %v (retorno %v)", tt.expectedRun, ran, executed)
+			}
+			if tt.locker.released != tt.expectedRels {
+				t.Errorf("liberações esperadas %d, obtidas %d", tt.expectedRels, tt.locker.released)
+			}
+		})
+	}
+}
+
+func TestLockKey(t *testing.T) {
+	if lockKey("task_overdue") != lockKey("task_overdue") {
+		t.Error("a chave do lock deveria ser estável para o mesmo job")
+	}
+	if lockKey("task_overdue") == lockKey("reminders") {
+		t.Error("jobs diferentes deveriam usar chaves diferentes")
+	}
+}
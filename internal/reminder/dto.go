@@ -0,0 +1,21 @@
+package reminder
+
+import "keep-your-house-clean/internal/domain"
+
+type CreateReminderRequest struct {
+	TaskID        *int64                 `json:"task_id"`
+	Trigger       domain.ReminderTrigger `json:"trigger"`
+	OffsetMinutes int                    `json:"offset_minutes"`
+	RepeatMinutes int                    `json:"repeat_minutes"`
+	Channel       domain.ReminderChannel `json:"channel"`
+	WebhookURL    *string                `json:"webhook_url"`
+}
+
+type UpdateReminderRequest struct {
+	Trigger       *domain.ReminderTrigger `json:"trigger"`
+	OffsetMinutes *int                    `json:"offset_minutes"`
+	RepeatMinutes *int                    `json:"repeat_minutes"`
+	Channel       *domain.ReminderChannel `json:"channel"`
+	WebhookURL    *string                 `json:"webhook_url"`
+	Enabled       *bool                   `json:"enabled"`
+}
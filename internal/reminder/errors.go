@@ -0,0 +1,14 @@
+package reminder
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrReminderNotFound     = errors.New("reminder not found")
+	ErrTaskNotFound         = errors.New("task not found")
+	ErrInvalidTrigger       = errors.New("trigger must be before_due or overdue")
+	ErrInvalidChannel       = errors.New("channel must be in_app, email or webhook")
+	ErrInvalidOffset        = errors.New("offset_minutes must be greater than zero for before_due reminders")
+	ErrInvalidRepeat        = errors.New("repeat_minutes must be zero or positive and is only allowed for overdue reminders")
+	ErrInvalidWebhookURL    = errors.New("webhook_url must be a valid http or https URL")
+)
@@ -0,0 +1,133 @@
+package reminder
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/api/v1/reminders", func(r chi.Router) {
+		r.Get("/", h.ListReminders)
+		r.Post("/", h.CreateReminder)
+		r.Get("/{id}", h.GetReminder)
+		r.Put("/{id}", h.UpdateReminder)
+		r.Delete("/{id}", h.DeleteReminder)
+	})
+}
+
+func (h *Handler) CreateReminder(w http.ResponseWriter, r *http.Request) {
+	var req CreateReminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	reminder, err := h.service.CreateReminder(r.Context(), req)
+	if err != nil {
+		respondWithReminderError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, reminder)
+}
+
+func (h *Handler) ListReminders(w http.ResponseWriter, r *http.Request) {
+	reminders, err := h.service.ListReminders(r.Context())
+	if err != nil {
+		respondWithReminderError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, reminders)
+}
+
+func (h *Handler) GetReminder(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid reminder ID")
+		return
+	}
+
+	reminder, err := h.service.GetReminder(r.Context(), id)
+	if err != nil {
+		respondWithReminderError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, reminder)
+}
+
+func (h *Handler) UpdateReminder(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid reminder ID")
+		return
+	}
+
+	var req UpdateReminderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	reminder, err := h.service.UpdateReminder(r.Context(), id, req)
+	if err != nil {
+		respondWithReminderError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, reminder)
+}
+
+func (h *Handler) DeleteReminder(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid reminder ID")
+		return
+	}
+
+	if err := h.service.DeleteReminder(r.Context(), id); err != nil {
+		respondWithReminderError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func respondWithReminderError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrUserNotAuthenticated):
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, ErrReminderNotFound), errors.Is(err, ErrTaskNotFound):
+		respondWithError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrInvalidTrigger), errors.Is(err, ErrInvalidChannel), errors.Is(err, ErrInvalidOffset),
+		errors.Is(err, ErrInvalidRepeat), errors.Is(err, ErrInvalidWebhookURL):
+		respondWithError(w, http.StatusBadRequest, err.Error())
+	default:
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(payload)
+}
+
+func respondWithError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,89 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/notifier"
+	"log"
+	"time"
+)
+
+const notificationType = "task.reminder"
+
+type Job struct {
+	repo      domain.ReminderRepository
+	notifiers map[domain.ReminderChannel]notifier.Notifier
+}
+
+func NewJob(repo domain.ReminderRepository, notifiers map[domain.ReminderChannel]notifier.Notifier) *Job {
+	return &Job{
+		repo:      repo,
+		notifiers: notifiers,
+	}
+}
+
+func (j *Job) Run(ctx context.Context) error {
+	now := time.Now()
+
+	candidates, err := j.repo.FindCandidates(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		slot, ok := candidate.Reminder.Slot(candidate.ScheduledTo, now)
+		if !ok {
+			continue
+		}
+
+		channel, ok := j.notifiers[candidate.Reminder.Channel]
+		if !ok {
+			log.Printf("No notifier configured for reminder channel %s", candidate.Reminder.Channel)
+			continue
+		}
+
+		recorded, err := j.repo.RecordDelivery(ctx, candidate.Reminder.ID, candidate.TaskID, slot)
+		if err != nil {
+			return err
+		}
+		if !recorded {
+			continue
+		}
+
+		if err := channel.Notify(ctx, buildMessage(candidate, now)); err != nil {
+			log.Printf("Failed to deliver reminder %d for task %d: %v", candidate.Reminder.ID, candidate.TaskID, err)
+			if err := j.repo.DeleteDelivery(ctx, candidate.Reminder.ID, candidate.TaskID, slot); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func buildMessage(candidate domain.ReminderCandidate, now time.Time) notifier.Message {
+	taskID := candidate.TaskID
+	message := notifier.Message{
+		TenantID: candidate.Reminder.TenantID,
+		UserID:   candidate.Reminder.UserID,
+		Email:    candidate.UserEmail,
+		Type:     notificationType,
+		TaskID:   &taskID,
+	}
+	if candidate.Reminder.WebhookURL != nil {
+		message.WebhookURL = *candidate.Reminder.WebhookURL
+	}
+
+	dueAt := candidate.ScheduledTo.Format("2006-01-02 15:04")
+	if candidate.Reminder.Trigger == domain.ReminderOverdue {
+		message.Title = fmt.Sprintf("Overdue: %s", candidate.TaskTitle)
+		message.Body = fmt.Sprintf("Hi %s, \"%s\" was due at %s and is still pending.", candidate.UserName, candidate.TaskTitle, dueAt)
+	} else {
+		remaining := candidate.ScheduledTo.Sub(now).Round(time.Minute)
+		message.Title = fmt.Sprintf("Reminder: %s", candidate.TaskTitle)
+		message.Body = fmt.Sprintf("Hi %s, \"%s\" is due at %s (in %s).", candidate.UserName, candidate.TaskTitle, dueAt, remaining)
+	}
+
+	return message
+}
@@ -0,0 +1,86 @@
+package mocks
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/notifier"
+	"time"
+)
+
+type MockReminderRepository struct {
+	CreateFunc         func(ctx context.Context, reminder *domain.Reminder) error
+	GetByIDFunc        func(ctx context.Context, id int64, tenantID int64) (*domain.Reminder, error)
+	FetchByUserFunc    func(ctx context.Context, userID int64, tenantID int64) ([]domain.Reminder, error)
+	UpdateFunc         func(ctx context.Context, reminder *domain.Reminder) error
+	DeleteFunc         func(ctx context.Context, id int64, tenantID int64) error
+	FindCandidatesFunc func(ctx context.Context, now time.Time) ([]domain.ReminderCandidate, error)
+	RecordDeliveryFunc func(ctx context.Context, reminderID int64, taskID int64, slot time.Time) (bool, error)
+	DeleteDeliveryFunc func(ctx context.Context, reminderID int64, taskID int64, slot time.Time) error
+}
+
+func (m *MockReminderRepository) Create(ctx context.Context, reminder *domain.Reminder) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, reminder)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Reminder, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockReminderRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64) ([]domain.Reminder, error) {
+	if m.FetchByUserFunc != nil {
+		return m.FetchByUserFunc(ctx, userID, tenantID)
+	}
+	return []domain.Reminder{}, nil
+}
+
+func (m *MockReminderRepository) Update(ctx context.Context, reminder *domain.Reminder) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, reminder)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, tenantID)
+	}
+	return nil
+}
+
+func (m *MockReminderRepository) FindCandidates(ctx context.Context, now time.Time) ([]domain.ReminderCandidate, error) {
+	if m.FindCandidatesFunc != nil {
+		return m.FindCandidatesFunc(ctx, now)
+	}
+	return []domain.ReminderCandidate{}, nil
+}
+
+func (m *MockReminderRepository) RecordDelivery(ctx context.Context, reminderID int64, taskID int64, slot time.Time) (bool, error) {
+	if m.RecordDeliveryFunc != nil {
+		return m.RecordDeliveryFunc(ctx, reminderID, taskID, slot)
+	}
+	return true, nil
+}
+
+func (m *MockReminderRepository) DeleteDelivery(ctx context.Context, reminderID int64, taskID int64, slot time.Time) error {
+	if m.DeleteDeliveryFunc != nil {
+		return m.DeleteDeliveryFunc(ctx, reminderID, taskID, slot)
+	}
+	return nil
+}
+
+type MockNotifier struct {
+	NotifyFunc func(ctx context.Context, message notifier.Message) error
+}
+
+func (m *MockNotifier) Notify(ctx context.Context, message notifier.Message) error {
+	if m.NotifyFunc != nil {
+		return m.NotifyFunc(ctx, message)
+	}
+	return nil
+}
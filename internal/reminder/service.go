@@ -0,0 +1,177 @@
+package reminder
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"net/url"
+	"time"
+)
+
+type Service struct {
+	repo     domain.ReminderRepository
+	taskRepo domain.TaskRepository
+}
+
+func NewService(repo domain.ReminderRepository, taskRepo domain.TaskRepository) *Service {
+	return &Service{
+		repo:     repo,
+		taskRepo: taskRepo,
+	}
+}
+
+func (s *Service) CreateReminder(ctx context.Context, req CreateReminderRequest) (*domain.Reminder, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	now := time.Now()
+	reminder := &domain.Reminder{
+		TenantID:      tenantID,
+		UserID:        userID,
+		Trigger:       req.Trigger,
+		OffsetMinutes: req.OffsetMinutes,
+		RepeatMinutes: req.RepeatMinutes,
+		Channel:       req.Channel,
+		WebhookURL:    req.WebhookURL,
+		Enabled:       true,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if req.TaskID != nil {
+		task, err := s.taskRepo.GetByID(ctx, *req.TaskID, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		if task == nil {
+			return nil, ErrTaskNotFound
+		}
+		reminder.TaskID = &task.ID
+		reminder.SeriesID = task.SeriesID
+	}
+
+	if err := validateReminder(reminder); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, reminder); err != nil {
+		return nil, err
+	}
+
+	return reminder, nil
+}
+
+func (s *Service) ListReminders(ctx context.Context) ([]domain.Reminder, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	return s.repo.FetchByUser(ctx, userID, tenantID)
+}
+
+func (s *Service) GetReminder(ctx context.Context, id int64) (*domain.Reminder, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	reminder, err := s.repo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if reminder == nil || reminder.UserID != userID {
+		return nil, ErrReminderNotFound
+	}
+
+	return reminder, nil
+}
+
+func (s *Service) UpdateReminder(ctx context.Context, id int64, req UpdateReminderRequest) (*domain.Reminder, error) {
+	reminder, err := s.GetReminder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Trigger != nil {
+		reminder.Trigger = *req.Trigger
+	}
+	if req.OffsetMinutes != nil {
+		reminder.OffsetMinutes = *req.OffsetMinutes
+	}
+	if req.RepeatMinutes != nil {
+		reminder.RepeatMinutes = *req.RepeatMinutes
+	}
+	if req.Channel != nil {
+		reminder.Channel = *req.Channel
+	}
+	if req.WebhookURL != nil {
+		reminder.WebhookURL = req.WebhookURL
+	}
+	if req.Enabled != nil {
+		reminder.Enabled = *req.Enabled
+	}
+
+	if err := validateReminder(reminder); err != nil {
+		return nil, err
+	}
+
+	reminder.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, reminder); err != nil {
+		return nil, err
+	}
+
+	return reminder, nil
+}
+
+func (s *Service) DeleteReminder(ctx context.Context, id int64) error {
+	reminder, err := s.GetReminder(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, reminder.ID, reminder.TenantID)
+}
+
+func validateReminder(reminder *domain.Reminder) error {
+	if !reminder.Trigger.IsValid() {
+		return ErrInvalidTrigger
+	}
+	if !reminder.Channel.IsValid() {
+		return ErrInvalidChannel
+	}
+
+	if reminder.Trigger == domain.ReminderBeforeDue {
+		if reminder.OffsetMinutes <= 0 {
+			return ErrInvalidOffset
+		}
+		if reminder.RepeatMinutes != 0 {
+			return ErrInvalidRepeat
+		}
+	} else {
+		reminder.OffsetMinutes = 0
+		if reminder.RepeatMinutes < 0 {
+			return ErrInvalidRepeat
+		}
+	}
+
+	if reminder.Channel != domain.ChannelWebhook {
+		reminder.WebhookURL = nil
+		return nil
+	}
+
+	if reminder.WebhookURL == nil {
+		return ErrInvalidWebhookURL
+	}
+	parsed, err := url.Parse(*reminder.WebhookURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"keep-your-house-clean/internal/platform/notifier"
+	"keep-your-house-clean/internal/reminder/mocks"
+	taskMocks "keep-your-house-clean/internal/task/mocks"
+	"testing"
+	"time"
+)
+
+func createContext(userID int64, tenantID int64) context.Context {
+	ctx := middleware.SetUserIDInContext(context.Background(), userID)
+	return middleware.SetTenantIDInContext(ctx, tenantID)
+}
+
+func TestService_CreateReminder(t *testing.T) {
+	webhookURL := "https://example.com/hook"
+	invalidURL := "ftp://example.com/hook"
+
+	tests := []struct {
+		name          string
+		req           CreateReminderRequest
+		task          *domain.Task
+		expectedError error
+	}{
+		{
+			name: "lembrete antes do vencimento",
+			req:  CreateReminderRequest{Trigger: domain.ReminderBeforeDue, OffsetMinutes: 60, Channel: domain.ChannelInApp},
+			task: nil,
+		},
+		{
+			name: "lembrete diário enquanto atrasada",
+			req:  CreateReminderRequest{Trigger: domain.ReminderOverdue, RepeatMinutes: 1440, Channel: domain.ChannelEmail},
+		},
+		{
+			name:          "gatilho inválido",
+			req:           CreateReminderRequest{Trigger: "later", Channel: domain.ChannelInApp},
+			expectedError: ErrInvalidTrigger,
+		},
+		{
+			name:          "canal inválido",
+			req:           CreateReminderRequest{Trigger: domain.ReminderOverdue, Channel: "sms"},
+			expectedError: ErrInvalidChannel,
+		},
+		{
+			name:          "antecedência obrigatória",
+			req:           CreateReminderRequest{Trigger: domain.ReminderBeforeDue, Channel: domain.ChannelInApp},
+			expectedError: ErrInvalidOffset,
+		},
+		{
+			name:          "repetição apenas para atrasadas",
+			req:           CreateReminderRequest{Trigger: domain.ReminderBeforeDue, OffsetMinutes: 30, RepeatMinutes: 60, Channel: domain.ChannelInApp},
+			expectedError: ErrInvalidRepeat,
+		},
+		{
+			name: "webhook com URL válida",
+			req:  CreateReminderRequest{Trigger: domain.ReminderOverdue, Channel: domain.ChannelWebhook, WebhookURL: &webhookURL},
+		},
+		{
+			name:          "webhook sem URL",
+			req:           CreateReminderRequest{Trigger: domain.ReminderOverdue, Channel: domain.ChannelWebhook},
+			expectedError: ErrInvalidWebhookURL,
+		},
+		{
+			name:          "webhook com esquema inválido",
+			req:           CreateReminderRequest{Trigger: domain.ReminderOverdue, Channel: domain.ChannelWebhook, WebhookURL: &invalidURL},
+			expectedError: ErrInvalidWebhookURL,
+		},
+		{
+			name:          "tarefa inexistente",
+			req:           CreateReminderRequest{TaskID: int64Ptr(99), Trigger: domain.ReminderOverdue, Channel: domain.ChannelInApp},
+			expectedError: ErrTaskNotFound,
+		},
+		{
+			name: "lembrete de tarefa recorrente acompanha a série",
+			req:  CreateReminderRequest{TaskID: int64Ptr(5), Trigger: domain.ReminderOverdue, Channel: domain.ChannelInApp},
+			task: &domain.Task{ID: 5, TenantID: 1, SeriesID: int64Ptr(7)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *domain.Reminder
+			repo := &mocks.MockReminderRepository{
+				CreateFunc: func(ctx context.Context, reminder *domain.Reminder) error {
+					created = reminder
+					return nil
+				},
+			}
+			taskRepo := &taskMocks.MockTaskRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return tt.task, nil
+				},
+			}
+
+			service := NewService(repo, taskRepo)
+			reminder, err := service.CreateReminder(createContext(1, 1), tt.req)
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("erro esperado %v, obtido %v", tt.expectedError, err)
+				}
+				if created != nil {
+					t.Error("lembrete não deveria ter sido criado")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if reminder.UserID != 1 || !reminder.Enabled {
+				t.Errorf("lembrete criado incorretamente: %+v", reminder)
+			}
+			if tt.task != nil && (reminder.SeriesID == nil || *reminder.SeriesID != *tt.task.SeriesID) {
+				t.Errorf("série esperada %v, obtida %v", tt.task.SeriesID, reminder.SeriesID)
+			}
+		})
+	}
+}
+
+func TestService_GetReminder_OtherUser(t *testing.T) {
+	repo := &mocks.MockReminderRepository{
+		GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Reminder, error) {
+			return &domain.Reminder{ID: id, UserID: 2, TenantID: tenantID}, nil
+		},
+	}
+
+	service := NewService(repo, &taskMocks.MockTaskRepository{})
+	_, err := service.GetReminder(createContext(1, 1), 1)
+	if !errors.Is(err, ErrReminderNotFound) {
+		t.Errorf("erro esperado %v, obtido %v", ErrReminderNotFound, err)
+	}
+}
+
+func TestJob_Run(t *testing.T) {
+	now := time.Now()
+	candidate := domain.ReminderCandidate{
+		Reminder: domain.Reminder{
+			ID:            1,
+			TenantID:      1,
+			UserID:        2,
+			Trigger:       domain.ReminderBeforeDue,
+			OffsetMinutes: 60,
+			Channel:       domain.ChannelInApp,
+			Enabled:       true,
+		},
+		TaskID:      10,
+		TaskTitle:   "Lavar louça",
+		ScheduledTo: now.Add(30 * time.Minute),
+		UserName:    "Ana",
+	}
+
+	tests := []struct {
+		name            string
+		candidate       domain.ReminderCandidate
+		alreadyRecorded bool
+		notifyErr       error
+		expectedSent    int
+		expectedDeleted int
+	}{
+		{
+			name:         "envia lembrete dentro da janela",
+			candidate:    candidate,
+			expectedSent: 1,
+		},
+		{
+			name:            "não reenvia lembrete já registrado",
+			candidate:       candidate,
+			alreadyRecorded: true,
+			expectedSent:    0,
+		},
+		{
+			name:            "libera o registro quando o envio falha",
+			candidate:       candidate,
+			notifyErr:       errors.New("delivery failed"),
+			expectedSent:    1,
+			expectedDeleted: 1,
+		},
+		{
+			name: "ignora lembrete fora da janela",
+			candidate: func() domain.ReminderCandidate {
+				c := candidate
+				c.ScheduledTo = now.Add(2 * time.Hour)
+				return c
+			}(),
+			expectedSent: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := 0
+			repo := &mocks.MockReminderRepository{
+				FindCandidatesFunc: func(ctx context.Context, now time.Time) ([]domain.ReminderCandidate, error) {
+					return []domain.ReminderCandidate{tt.candidate}, nil
+				},
+				RecordDeliveryFunc: func(ctx context.Context, reminderID int64, taskID int64, slot time.Time) (bool, error) {
+					return !tt.alreadyRecorded, nil
+				},
+				DeleteDeliveryFunc: func(ctx context.Context, reminderID int64, taskID int64, slot time.Time) error {
+					deleted++
+					return nil
+				},
+			}
+
+			var sent []notifier.Message
+			inApp := &mocks.MockNotifier{
+				NotifyFunc: func(ctx context.Context, message notifier.Message) error {
+					sent = append(sent, message)
+					return tt.notifyErr
+				},
+			}
+
+			job := NewJob(repo, map[domain.ReminderChannel]notifier.Notifier{domain.ChannelInApp: inApp})
+			if err := job.Run(context.Background()); err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if len(sent) != tt.expectedSent {
+				t.Errorf("envios esperados %d, obtidos %d", tt.expectedSent, len(sent))
+			}
+			if deleted != tt.expectedDeleted {
+				t.Errorf("registros removidos esperados %d, obtidos %d", tt.expectedDeleted, deleted)
+			}
+			if len(sent) > 0 && (sent[0].UserID != 2 || sent[0].TaskID == nil || *sent[0].TaskID != 10) {
+				t.Errorf("mensagem enviada incorretamente: %+v", sent[0])
+			}
+		})
+	}
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
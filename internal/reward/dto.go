@@ -0,0 +1,28 @@
+package reward
+
+import "keep-your-house-clean/internal/domain"
+
+type CreateRewardRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Cost        int    `json:"cost"`
+	Stock       *int   `json:"stock"`
+}
+
+type UpdateRewardRequest struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Cost        *int    `json:"cost"`
+	Stock       *int    `json:"stock"`
+	ClearStock  bool    `json:"clear_stock"`
+	Active      *bool   `json:"active"`
+}
+
+type RedemptionFilter struct {
+	UserID *int64
+	Status *domain.RedemptionStatus
+}
+
+type DecideRedemptionRequest struct {
+	Note *string `json:"note"`
+}
@@ -0,0 +1,14 @@
+package reward
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrRewardNotFound       = errors.New("reward not found")
+	ErrInvalidReward        = errors.New("reward must have a title, a positive cost and a non-negative stock")
+	ErrRewardUnavailable    = errors.New("reward is inactive or out of stock")
+	ErrInsufficientPoints   = errors.New("not enough points to redeem this reward")
+	ErrRedemptionNotFound   = errors.New("redemption not found")
+	ErrRedemptionNotPending = errors.New("redemption has already been decided")
+	ErrInvalidStatus        = errors.New("invalid redemption status")
+)
@@ -0,0 +1,224 @@
+package reward
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Route("/api/v1/rewards", func(r chi.Router) {
+		r.Get("/", h.ListRewards)
+		r.With(middleware.RequirePermission(middleware.PermissionManageRewards)).Post("/", h.CreateReward)
+		r.Get("/redemptions", h.ListRedemptions)
+		r.With(middleware.RequirePermission(middleware.PermissionManageRewards)).Post("/redemptions/{id}/approve", h.ApproveRedemption)
+		r.With(middleware.RequirePermission(middleware.PermissionManageRewards)).Post("/redemptions/{id}/reject", h.RejectRedemption)
+		r.Get("/{id}", h.GetReward)
+		r.With(middleware.RequirePermission(middleware.PermissionManageRewards)).Put("/{id}", h.UpdateReward)
+		r.With(middleware.RequirePermission(middleware.PermissionManageRewards)).Delete("/{id}", h.DeleteReward)
+		r.Post("/{id}/redeem", h.RedeemReward)
+	})
+}
+
+func (h *Handler) CreateReward(w http.ResponseWriter, r *http.Request) {
+	var req CreateRewardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	reward, err := h.service.CreateReward(r.Context(), req)
+	if err != nil {
+		respondWithRewardError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, reward)
+}
+
+func (h *Handler) ListRewards(w http.ResponseWriter, r *http.Request) {
+	rewards, err := h.service.ListRewards(r.Context())
+	if err != nil {
+		respondWithRewardError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, rewards)
+}
+
+func (h *Handler) GetReward(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid reward ID")
+		return
+	}
+
+	reward, err := h.service.GetReward(r.Context(), id)
+	if err != nil {
+		respondWithRewardError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, reward)
+}
+
+func (h *Handler) UpdateReward(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid reward ID")
+		return
+	}
+
+	var req UpdateRewardRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	reward, err := h.service.UpdateReward(r.Context(), id, req)
+	if err != nil {
+		respondWithRewardError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, reward)
+}
+
+func (h *Handler) DeleteReward(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid reward ID")
+		return
+	}
+
+	if err := h.service.DeleteReward(r.Context(), id); err != nil {
+		respondWithRewardError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) RedeemReward(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid reward ID")
+		return
+	}
+
+	redemption, err := h.service.RedeemReward(r.Context(), id)
+	if err != nil {
+		respondWithRewardError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, redemption)
+}
+
+func (h *Handler) ListRedemptions(w http.ResponseWriter, r *http.Request) {
+	var filter RedemptionFilter
+
+	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
+		status := domain.RedemptionStatus(statusStr)
+		filter.Status = &status
+	}
+
+	if userIDStr := r.URL.Query().Get("user_id"); userIDStr != "" {
+		userID, err := strconv.ParseInt(userIDStr, 10, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+			return
+		}
+		filter.UserID = &userID
+	}
+
+	redemptions, err := h.service.ListRedemptions(r.Context(), filter)
+	if err != nil {
+		respondWithRewardError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, redemptions)
+}
+
+func (h *Handler) ApproveRedemption(w http.ResponseWriter, r *http.Request) {
+	h.decideRedemption(w, r, h.service.ApproveRedemption)
+}
+
+func (h *Handler) RejectRedemption(w http.ResponseWriter, r *http.Request) {
+	h.decideRedemption(w, r, h.service.RejectRedemption)
+}
+
+func (h *Handler) decideRedemption(w http.ResponseWriter, r *http.Request, decide func(ctx context.Context, id int64, req DecideRedemptionRequest) (*domain.RewardRedemption, error)) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid redemption ID")
+		return
+	}
+
+	var req DecideRedemptionRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	redemption, err := decide(r.Context(), id, req)
+	if err != nil {
+		respondWithRewardError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, redemption)
+}
+
+func respondWithRewardError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrUserNotAuthenticated) {
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+	if middleware.IsForbidden(err) {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if errors.Is(err, ErrRewardNotFound) || errors.Is(err, ErrRedemptionNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if errors.Is(err, ErrInvalidReward) || errors.Is(err, ErrInvalidStatus) {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, ErrRewardUnavailable) || errors.Is(err, ErrInsufficientPoints) || errors.Is(err, ErrRedemptionNotPending) {
+		respondWithError(w, http.StatusConflict, err.Error())
+		return
+	}
+	respondWithError(w, http.StatusInternalServerError, err.Error())
+}
+
+func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(payload)
+}
+
+func respondWithError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,155 @@
+package mocks
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type MockRewardRepository struct {
+	CreateFunc       func(ctx context.Context, reward *domain.Reward) error
+	GetByIDFunc      func(ctx context.Context, id int64, tenantID int64) (*domain.Reward, error)
+	FetchAllFunc     func(ctx context.Context, tenantID int64, activeOnly bool) ([]domain.Reward, error)
+	UpdateFunc       func(ctx context.Context, reward *domain.Reward) error
+	DeleteFunc       func(ctx context.Context, id int64, tenantID int64) error
+	ReserveStockFunc func(ctx context.Context, id int64, tenantID int64) (bool, error)
+	ReleaseStockFunc func(ctx context.Context, id int64, tenantID int64) error
+}
+
+func (m *MockRewardRepository) Create(ctx context.Context, reward *domain.Reward) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, reward)
+	}
+	return nil
+}
+
+func (m *MockRewardRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Reward, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockRewardRepository) FetchAll(ctx context.Context, tenantID int64, activeOnly bool) ([]domain.Reward, error) {
+	if m.FetchAllFunc != nil {
+		return m.FetchAllFunc(ctx, tenantID, activeOnly)
+	}
+	return []domain.Reward{}, nil
+}
+
+func (m *MockRewardRepository) Update(ctx context.Context, reward *domain.Reward) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, reward)
+	}
+	return nil
+}
+
+func (m *MockRewardRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, tenantID)
+	}
+	return nil
+}
+
+func (m *MockRewardRepository) ReserveStock(ctx context.Context, id int64, tenantID int64) (bool, error) {
+	if m.ReserveStockFunc != nil {
+		return m.ReserveStockFunc(ctx, id, tenantID)
+	}
+	return true, nil
+}
+
+func (m *MockRewardRepository) ReleaseStock(ctx context.Context, id int64, tenantID int64) error {
+	if m.ReleaseStockFunc != nil {
+		return m.ReleaseStockFunc(ctx, id, tenantID)
+	}
+	return nil
+}
+
+type MockRewardRedemptionRepository struct {
+	CreateFunc   func(ctx context.Context, redemption *domain.RewardRedemption) error
+	GetByIDFunc  func(ctx context.Context, id int64, tenantID int64) (*domain.RewardRedemption, error)
+	FetchAllFunc func(ctx context.Context, tenantID int64, userID *int64, status *domain.RedemptionStatus) ([]domain.RewardRedemption, error)
+	DecideFunc   func(ctx context.Context, redemption *domain.RewardRedemption) error
+}
+
+func (m *MockRewardRedemptionRepository) Create(ctx context.Context, redemption *domain.RewardRedemption) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, redemption)
+	}
+	return nil
+}
+
+func (m *MockRewardRedemptionRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.RewardRedemption, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockRewardRedemptionRepository) FetchAll(ctx context.Context, tenantID int64, userID *int64, status *domain.RedemptionStatus) ([]domain.RewardRedemption, error) {
+	if m.FetchAllFunc != nil {
+		return m.FetchAllFunc(ctx, tenantID, userID, status)
+	}
+	return []domain.RewardRedemption{}, nil
+}
+
+func (m *MockRewardRedemptionRepository) Decide(ctx context.Context, redemption *domain.RewardRedemption) error {
+	if m.DecideFunc != nil {
+		return m.DecideFunc(ctx, redemption)
+	}
+	return nil
+}
+
+type MockPointsLedgerRepository struct {
+	PostFunc        func(ctx context.Context, entry *domain.PointsEntry) (bool, error)
+	SpendFunc       func(ctx context.Context, entry *domain.PointsEntry) (bool, error)
+	FetchByUserFunc func(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.PointsEntry, error)
+	GetBalanceFunc  func(ctx context.Context, userID int64, tenantID int64) (int, error)
+	SumByUserFunc   func(ctx context.Context, tenantID int64, from time.Time, to time.Time, reasons []domain.PointsReason) (map[int64]int, error)
+}
+
+func (m *MockPointsLedgerRepository) Post(ctx context.Context, entry *domain.PointsEntry) (bool, error) {
+	if m.PostFunc != nil {
+		return m.PostFunc(ctx, entry)
+	}
+	return true, nil
+}
+
+func (m *MockPointsLedgerRepository) Spend(ctx context.Context, entry *domain.PointsEntry) (bool, error) {
+	if m.SpendFunc != nil {
+		return m.SpendFunc(ctx, entry)
+	}
+	return true, nil
+}
+
+func (m *MockPointsLedgerRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.PointsEntry, error) {
+	if m.FetchByUserFunc != nil {
+		return m.FetchByUserFunc(ctx, userID, tenantID, limit, offset)
+	}
+	return []domain.PointsEntry{}, nil
+}
+
+func (m *MockPointsLedgerRepository) GetBalance(ctx context.Context, userID int64, tenantID int64) (int, error) {
+	if m.GetBalanceFunc != nil {
+		return m.GetBalanceFunc(ctx, userID, tenantID)
+	}
+	return 0, nil
+}
+
+func (m *MockPointsLedgerRepository) SumByUser(ctx context.Context, tenantID int64, from time.Time, to time.Time, reasons []domain.PointsReason) (map[int64]int, error) {
+	if m.SumByUserFunc != nil {
+		return m.SumByUserFunc(ctx, tenantID, from, to, reasons)
+	}
+	return map[int64]int{}, nil
+}
+
+type MockTransactor struct {
+	WithinTransactionFunc func(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.WithinTransactionFunc != nil {
+		return m.WithinTransactionFunc(ctx, fn)
+	}
+	return fn(ctx)
+}
@@ -0,0 +1,354 @@
+package reward
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"strings"
+	"time"
+)
+
+type Service struct {
+	repo           domain.RewardRepository
+	redemptionRepo domain.RewardRedemptionRepository
+	ledgerRepo     domain.PointsLedgerRepository
+	transactor     domain.Transactor
+}
+
+func NewService(repo domain.RewardRepository, redemptionRepo domain.RewardRedemptionRepository, ledgerRepo domain.PointsLedgerRepository, transactor domain.Transactor) *Service {
+	return &Service{
+		repo:           repo,
+		redemptionRepo: redemptionRepo,
+		ledgerRepo:     ledgerRepo,
+		transactor:     transactor,
+	}
+}
+
+func (s *Service) CreateReward(ctx context.Context, req CreateRewardRequest) (*domain.Reward, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageRewards); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reward := &domain.Reward{
+		TenantID:    tenantID,
+		Title:       strings.TrimSpace(req.Title),
+		Description: req.Description,
+		Cost:        req.Cost,
+		Stock:       req.Stock,
+		Active:      true,
+		CreatedAt:   now,
+		CreatedById: &userID,
+		UpdatedAt:   now,
+		UpdatedById: &userID,
+	}
+
+	if err := validateReward(reward); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, reward); err != nil {
+		return nil, err
+	}
+
+	return reward, nil
+}
+
+func (s *Service) ListRewards(ctx context.Context) ([]domain.Reward, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	activeOnly := !middleware.IsAdmin(ctx)
+	rewards, err := s.repo.FetchAll(ctx, tenantID, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if rewards == nil {
+		rewards = []domain.Reward{}
+	}
+
+	return rewards, nil
+}
+
+func (s *Service) GetReward(ctx context.Context, id int64) (*domain.Reward, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	reward, err := s.repo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reward == nil || (!reward.Active && !middleware.IsAdmin(ctx)) {
+		return nil, ErrRewardNotFound
+	}
+
+	return reward, nil
+}
+
+func (s *Service) UpdateReward(ctx context.Context, id int64, req UpdateRewardRequest) (*domain.Reward, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageRewards); err != nil {
+		return nil, err
+	}
+
+	reward, err := s.repo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reward == nil {
+		return nil, ErrRewardNotFound
+	}
+
+	if req.Title != nil {
+		reward.Title = strings.TrimSpace(*req.Title)
+	}
+	if req.Description != nil {
+		reward.Description = *req.Description
+	}
+	if req.Cost != nil {
+		reward.Cost = *req.Cost
+	}
+	if req.ClearStock {
+		reward.Stock = nil
+	} else if req.Stock != nil {
+		reward.Stock = req.Stock
+	}
+	if req.Active != nil {
+		reward.Active = *req.Active
+	}
+
+	if err := validateReward(reward); err != nil {
+		return nil, err
+	}
+
+	reward.UpdatedAt = time.Now()
+	reward.UpdatedById = &userID
+
+	if err := s.repo.Update(ctx, reward); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRewardNotFound
+		}
+		return nil, err
+	}
+
+	return reward, nil
+}
+
+func (s *Service) DeleteReward(ctx context.Context, id int64) error {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageRewards); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrRewardNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) RedeemReward(ctx context.Context, id int64) (*domain.RewardRedemption, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	var redemption *domain.RewardRedemption
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		reward, err := s.repo.GetByID(ctx, id, tenantID)
+		if err != nil {
+			return err
+		}
+
+		if reward == nil {
+			return ErrRewardNotFound
+		}
+
+		reserved, err := s.repo.ReserveStock(ctx, reward.ID, tenantID)
+		if err != nil {
+			return err
+		}
+
+		if !reserved {
+			return ErrRewardUnavailable
+		}
+
+		now := time.Now()
+		redemption = &domain.RewardRedemption{
+			TenantID:    tenantID,
+			RewardID:    reward.ID,
+			RewardTitle: reward.Title,
+			UserID:      userID,
+			Cost:        reward.Cost,
+			Status:      domain.RedemptionPending,
+			CreatedAt:   now,
+		}
+
+		if err := s.redemptionRepo.Create(ctx, redemption); err != nil {
+			return err
+		}
+
+		_, err = s.ledgerRepo.Spend(ctx, &domain.PointsEntry{
+			TenantID:       tenantID,
+			UserID:         userID,
+			Delta:          -redemption.Cost,
+			Reason:         domain.PointsReasonRewardRedeemed,
+			SourceType:     domain.PointsSourceRedemption,
+			SourceID:       redemption.ID,
+			IdempotencyKey: fmt.Sprintf("%s:%d", domain.PointsReasonRewardRedeemed, redemption.ID),
+			CreatedAt:      now,
+		})
+		if errors.Is(err, domain.ErrInsufficientPoints) {
+			return ErrInsufficientPoints
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return redemption, nil
+}
+
+func (s *Service) ListRedemptions(ctx context.Context, filter RedemptionFilter) ([]domain.RewardRedemption, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if filter.Status != nil && !filter.Status.IsValid() {
+		return nil, ErrInvalidStatus
+	}
+
+	if !middleware.IsAdmin(ctx) {
+		filter.UserID = &userID
+	}
+
+	redemptions, err := s.redemptionRepo.FetchAll(ctx, tenantID, filter.UserID, filter.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if redemptions == nil {
+		redemptions = []domain.RewardRedemption{}
+	}
+
+	return redemptions, nil
+}
+
+func (s *Service) ApproveRedemption(ctx context.Context, id int64, req DecideRedemptionRequest) (*domain.RewardRedemption, error) {
+	return s.decide(ctx, id, domain.RedemptionApproved, req)
+}
+
+func (s *Service) RejectRedemption(ctx context.Context, id int64, req DecideRedemptionRequest) (*domain.RewardRedemption, error) {
+	return s.decide(ctx, id, domain.RedemptionRejected, req)
+}
+
+func (s *Service) decide(ctx context.Context, id int64, status domain.RedemptionStatus, req DecideRedemptionRequest) (*domain.RewardRedemption, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageRewards); err != nil {
+		return nil, err
+	}
+
+	var redemption *domain.RewardRedemption
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		redemption, err = s.redemptionRepo.GetByID(ctx, id, tenantID)
+		if err != nil {
+			return err
+		}
+
+		if redemption == nil {
+			return ErrRedemptionNotFound
+		}
+
+		if redemption.Status != domain.RedemptionPending {
+			return ErrRedemptionNotPending
+		}
+
+		now := time.Now()
+		redemption.Status = status
+		redemption.Note = req.Note
+		redemption.DecidedById = &userID
+		redemption.DecidedAt = &now
+
+		if err := s.redemptionRepo.Decide(ctx, redemption); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrRedemptionNotPending
+			}
+			return err
+		}
+
+		if status != domain.RedemptionRejected {
+			return nil
+		}
+
+		if err := s.repo.ReleaseStock(ctx, redemption.RewardID, tenantID); err != nil {
+			return err
+		}
+
+		_, err = s.ledgerRepo.Post(ctx, &domain.PointsEntry{
+			TenantID:       tenantID,
+			UserID:         redemption.UserID,
+			Delta:          redemption.Cost,
+			Reason:         domain.PointsReasonRewardRefunded,
+			SourceType:     domain.PointsSourceRedemption,
+			SourceID:       redemption.ID,
+			IdempotencyKey: fmt.Sprintf("%s:%d", domain.PointsReasonRewardRefunded, redemption.ID),
+			CreatedAt:      now,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return redemption, nil
+}
+
+func validateReward(reward *domain.Reward) error {
+	if reward.Title == "" || reward.Cost <= 0 {
+		return ErrInvalidReward
+	}
+
+	if reward.Stock != nil && *reward.Stock < 0 {
+		return ErrInvalidReward
+	}
+
+	return nil
+}
@@ -0,0 +1,305 @@
+package reward
+
+import (
+	"context"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"keep-your-house-clean/internal/reward/mocks"
+	"testing"
+)
+
+func createContext(userID int64, role string) context.Context {
+	ctx := middleware.SetUserIDInContext(context.Background(), userID)
+	ctx = middleware.SetTenantIDInContext(ctx, 1)
+	return middleware.SetRoleInContext(ctx, role)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestService_CreateReward(t *testing.T) {
+	tests := []struct {
+		name          string
+		role          string
+		req           CreateRewardRequest
+		expectedError error
+		expectForbid  bool
+	}{
+		{
+			name: "admin cria recompensa com estoque",
+			role: domain.RoleAdmin,
+			req:  CreateRewardRequest{Title: "Pular a louça uma vez", Cost: 50, Stock: intPtr(3)},
+		},
+		{
+			name: "admin cria recompensa sem limite de estoque",
+			role: domain.RoleAdmin,
+			req:  CreateRewardRequest{Title: "Escolher o filme", Cost: 20},
+		},
+		{
+			name:          "rejeita custo zero",
+			role:          domain.RoleAdmin,
+			req:           CreateRewardRequest{Title: "Grátis", Cost: 0},
+			expectedError: ErrInvalidReward,
+		},
+		{
+			name:          "rejeita estoque negativo",
+			role:          domain.RoleAdmin,
+			req:           CreateRewardRequest{Title: "Sorvete", Cost: 10, Stock: intPtr(-1)},
+			expectedError: ErrInvalidReward,
+		},
+		{
+			name:          "rejeita título vazio",
+			role:          domain.RoleAdmin,
+			req:           CreateRewardRequest{Title: "  ", Cost: 10},
+			expectedError: ErrInvalidReward,
+		},
+		{
+			name:         "membro não pode criar recompensas",
+			role:         domain.RoleUser,
+			req:          CreateRewardRequest{Title: "Escolher o filme", Cost: 20},
+			expectForbid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			repo := &mocks.MockRewardRepository{
+				CreateFunc: func(ctx context.Context, reward *domain.Reward) error {
+					created = true
+					reward.ID = 1
+					return nil
+				},
+			}
+
+			service := NewService(repo, &mocks.MockRewardRedemptionRepository{}, &mocks.MockPointsLedgerRepository{}, &mocks.MockTransactor{})
+			reward, err := service.CreateReward(createContext(1, tt.role), tt.req)
+
+			if tt.expectForbid {
+				if !middleware.IsForbidden(err) {
+					t.Fatalf("esperado erro de permissão, obtido %v", err)
+				}
+				return
+			}
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("esperado erro %v, obtido %v", tt.expectedError, err)
+				}
+				if created {
+					t.Error("recompensa inválida não deveria ser criada")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if !reward.Active {
+				t.Error("recompensa deveria ser criada ativa")
+			}
+		})
+	}
+}
+
+func TestService_RedeemReward(t *testing.T) {
+	tests := []struct {
+		name          string
+		reward        *domain.Reward
+		reserved      bool
+		spendErr      error
+		expectedError error
+		expectSpend   bool
+	}{
+		{
+			name:        "resgata recompensa e debita os pontos",
+			reward:      &domain.Reward{ID: 1, TenantID: 1, Title: "Escolher o filme", Cost: 20, Active: true},
+			reserved:    true,
+			expectSpend: true,
+		},
+		{
+			name:          "recompensa inexistente",
+			expectedError: ErrRewardNotFound,
+		},
+		{
+			name:          "recompensa sem estoque",
+			reward:        &domain.Reward{ID: 1, TenantID: 1, Title: "Sorvete", Cost: 10, Stock: intPtr(0), Active: true},
+			reserved:      false,
+			expectedError: ErrRewardUnavailable,
+		},
+		{
+			name:          "saldo insuficiente",
+			reward:        &domain.Reward{ID: 1, TenantID: 1, Title: "Pular a louça", Cost: 500, Active: true},
+			reserved:      true,
+			spendErr:      domain.ErrInsufficientPoints,
+			expectedError: ErrInsufficientPoints,
+			expectSpend:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spent *domain.PointsEntry
+			repo := &mocks.MockRewardRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Reward, error) {
+					return tt.reward, nil
+				},
+				ReserveStockFunc: func(ctx context.Context, id int64, tenantID int64) (bool, error) {
+					return tt.reserved, nil
+				},
+			}
+			redemptionRepo := &mocks.MockRewardRedemptionRepository{
+				CreateFunc: func(ctx context.Context, redemption *domain.RewardRedemption) error {
+					redemption.ID = 7
+					return nil
+				},
+			}
+			ledgerRepo := &mocks.MockPointsLedgerRepository{
+				SpendFunc: func(ctx context.Context, entry *domain.PointsEntry) (bool, error) {
+					spent = entry
+					return tt.spendErr == nil, tt.spendErr
+				},
+			}
+
+			transactions := 0
+			transactor := &mocks.MockTransactor{
+				WithinTransactionFunc: func(ctx context.Context, fn func(ctx context.Context) error) error {
+					transactions++
+					return fn(ctx)
+				},
+			}
+
+			service := NewService(repo, redemptionRepo, ledgerRepo, transactor)
+			redemption, err := service.RedeemReward(createContext(2, domain.RoleUser), 1)
+
+			if transactions != 1 {
+				t.Errorf("esperada 1 transação, obtidas %d", transactions)
+			}
+			if tt.expectSpend != (spent != nil) {
+				t.Errorf("débito esperado: %v, obtido: %v", tt.expectSpend, spent != nil)
+			}
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("esperado erro %v, obtido %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if redemption.Status != domain.RedemptionPending {
+				t.Errorf("status esperado %s, obtido %s", domain.RedemptionPending, redemption.Status)
+			}
+			if spent.Delta != -tt.reward.Cost || spent.UserID != 2 || spent.SourceID != redemption.ID {
+				t.Errorf("lançamento de débito incorreto: %+v", spent)
+			}
+		})
+	}
+}
+
+func TestService_DecideRedemption(t *testing.T) {
+	tests := []struct {
+		name           string
+		reject         bool
+		status         domain.RedemptionStatus
+		role           string
+		expectedStatus domain.RedemptionStatus
+		expectedError  error
+		expectForbid   bool
+		expectRefund   bool
+	}{
+		{
+			name:           "aprovação não devolve pontos",
+			status:         domain.RedemptionPending,
+			role:           domain.RoleAdmin,
+			expectedStatus: domain.RedemptionApproved,
+		},
+		{
+			name:           "rejeição devolve pontos e estoque",
+			reject:         true,
+			status:         domain.RedemptionPending,
+			role:           domain.RoleAdmin,
+			expectedStatus: domain.RedemptionRejected,
+			expectRefund:   true,
+		},
+		{
+			name:          "não decide resgate já decidido",
+			reject:        true,
+			status:        domain.RedemptionApproved,
+			role:          domain.RoleAdmin,
+			expectedError: ErrRedemptionNotPending,
+		},
+		{
+			name:         "membro não pode decidir resgates",
+			status:       domain.RedemptionPending,
+			role:         domain.RoleUser,
+			expectForbid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var refund *domain.PointsEntry
+			released := false
+
+			repo := &mocks.MockRewardRepository{
+				ReleaseStockFunc: func(ctx context.Context, id int64, tenantID int64) error {
+					released = true
+					return nil
+				},
+			}
+			redemptionRepo := &mocks.MockRewardRedemptionRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.RewardRedemption, error) {
+					return &domain.RewardRedemption{ID: id, TenantID: tenantID, RewardID: 3, UserID: 2, Cost: 40, Status: tt.status}, nil
+				},
+			}
+			ledgerRepo := &mocks.MockPointsLedgerRepository{
+				PostFunc: func(ctx context.Context, entry *domain.PointsEntry) (bool, error) {
+					refund = entry
+					return true, nil
+				},
+			}
+
+			service := NewService(repo, redemptionRepo, ledgerRepo, &mocks.MockTransactor{})
+			ctx := createContext(1, tt.role)
+
+			var redemption *domain.RewardRedemption
+			var err error
+			if tt.reject {
+				redemption, err = service.RejectRedemption(ctx, 7, DecideRedemptionRequest{})
+			} else {
+				redemption, err = service.ApproveRedemption(ctx, 7, DecideRedemptionRequest{})
+			}
+
+			if tt.expectForbid {
+				if !middleware.IsForbidden(err) {
+					t.Fatalf("esperado erro de permissão, obtido %v", err)
+				}
+				return
+			}
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("esperado erro %v, obtido %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if redemption.Status != tt.expectedStatus {
+				t.Errorf("status esperado %s, obtido %s", tt.expectedStatus, redemption.Status)
+			}
+			if redemption.DecidedById == nil || *redemption.DecidedById != 1 {
+				t.Error("resgate deveria registrar quem decidiu")
+			}
+			if tt.expectRefund != (refund != nil) || tt.expectRefund != released {
+				t.Fatalf("estorno esperado: %v, lançamento: %v, estoque devolvido: %v", tt.expectRefund, refund != nil, released)
+			}
+			if refund != nil && (refund.Delta != 40 || refund.UserID != 2 || refund.Reason != domain.PointsReasonRewardRefunded) {
+				t.Errorf("lançamento de estorno incorreto: %+v", refund)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package stats
+
+import (
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type StatsQuery struct {
+	Period domain.LeaderboardPeriod
+	From   *time.Time
+	To     *time.Time
+	Now    time.Time
+}
+
+type TotalsStats struct {
+	Completed           int      `json:"completed"`
+	PointsEarned        int      `json:"points_earned"`
+	OnTime              int      `json:"on_time"`
+	Late                int      `json:"late"`
+	OnTimePercentage    float64  `json:"on_time_percentage"`
+	LatePercentage      float64  `json:"late_percentage"`
+	AverageDelayMinutes *float64 `json:"average_delay_minutes"`
+}
+
+type UserStats struct {
+	UserID              int64    `json:"user_id"`
+	Name                string   `json:"name"`
+	Completed           int      `json:"completed"`
+	PointsEarned        int      `json:"points_earned"`
+	OnTime              int      `json:"on_time"`
+	Late                int      `json:"late"`
+	OnTimePercentage    float64  `json:"on_time_percentage"`
+	LatePercentage      float64  `json:"late_percentage"`
+	AverageDelayMinutes *float64 `json:"average_delay_minutes"`
+	CompletionShare     float64  `json:"completion_share"`
+	WorkShare           float64  `json:"work_share"`
+}
+
+type WeekdayStats struct {
+	Weekday   string `json:"weekday"`
+	Completed int    `json:"completed"`
+}
+
+type StatsResponse struct {
+	Period          domain.LeaderboardPeriod `json:"period"`
+	Start           time.Time                `json:"start"`
+	End             time.Time                `json:"end"`
+	Totals          TotalsStats              `json:"totals"`
+	Users           []UserStats              `json:"users"`
+	BusiestWeekdays []WeekdayStats           `json:"busiest_weekdays"`
+}
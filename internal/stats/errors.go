@@ -0,0 +1,8 @@
+package stats
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrInvalidRange         = errors.New("invalid stats range")
+)
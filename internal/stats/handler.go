@@ -0,0 +1,95 @@
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/domain"
+)
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Get("/api/v1/stats", h.GetStats)
+}
+
+func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+
+	location := time.Local
+	if tz := values.Get("tz"); tz != "" {
+		loaded, err := time.LoadLocation(tz)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid timezone")
+			return
+		}
+		location = loaded
+	}
+
+	query := StatsQuery{
+		Period: domain.LeaderboardPeriod(values.Get("period")),
+		Now:    time.Now().In(location),
+	}
+
+	if fromStr := values.Get("from"); fromStr != "" {
+		from, _, err := domain.ParseLeaderboardTime(fromStr, location)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid from date")
+			return
+		}
+		query.From = &from
+	}
+
+	if toStr := values.Get("to"); toStr != "" {
+		to, dateOnly, err := domain.ParseLeaderboardTime(toStr, location)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid to date")
+			return
+		}
+		if dateOnly {
+			to = to.AddDate(0, 0, 1)
+		}
+		query.To = &to
+	}
+
+	if query.Period == "" && (query.From != nil || query.To != nil) {
+		query.Period = domain.LeaderboardCustom
+	}
+
+	stats, err := h.service.GetStats(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidRange) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, stats)
+}
+
+func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(payload)
+}
+
+func respondWithError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
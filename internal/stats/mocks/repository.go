@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+type MockStatsRepository struct {
+	FetchUserCompletionsFunc    func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.UserCompletionStats, error)
+	FetchPointsEarnedFunc       func(ctx context.Context, tenantID int64, from time.Time, to time.Time) (map[int64]int, error)
+	FetchWeekdayCompletionsFunc func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.WeekdayCompletions, error)
+}
+
+func (m *MockStatsRepository) FetchUserCompletions(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.UserCompletionStats, error) {
+	if m.FetchUserCompletionsFunc != nil {
+		return m.FetchUserCompletionsFunc(ctx, tenantID, from, to)
+	}
+	return []domain.UserCompletionStats{}, nil
+}
+
+func (m *MockStatsRepository) FetchPointsEarned(ctx context.Context, tenantID int64, from time.Time, to time.Time) (map[int64]int, error) {
+	if m.FetchPointsEarnedFunc != nil {
+		return m.FetchPointsEarnedFunc(ctx, tenantID, from, to)
+	}
+	return map[int64]int{}, nil
+}
+
+func (m *MockStatsRepository) FetchWeekdayCompletions(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.WeekdayCompletions, error) {
+	if m.FetchWeekdayCompletionsFunc != nil {
+		return m.FetchWeekdayCompletionsFunc(ctx, tenantID, from, to)
+	}
+	return []domain.WeekdayCompletions{}, nil
+}
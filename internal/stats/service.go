@@ -0,0 +1,154 @@
+package stats
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"math"
+	"sort"
+	"time"
+)
+
+type Service struct {
+	repo domain.StatsRepository
+}
+
+func NewService(repo domain.StatsRepository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) GetStats(ctx context.Context, query StatsQuery) (*StatsResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	period := query.Period
+	if period == "" {
+		period = domain.LeaderboardRolling30
+	}
+	if !period.IsValid() {
+		return nil, ErrInvalidRange
+	}
+
+	now := query.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	window, _, err := domain.ResolveLeaderboardWindows(period, now, query.From, query.To)
+	if err != nil {
+		return nil, ErrInvalidRange
+	}
+	queryRange := window.UTC()
+
+	completions, err := s.repo.FetchUserCompletions(ctx, tenantID, queryRange.Start, queryRange.End)
+	if err != nil {
+		return nil, err
+	}
+
+	pointsEarned, err := s.repo.FetchPointsEarned(ctx, tenantID, queryRange.Start, queryRange.End)
+	if err != nil {
+		return nil, err
+	}
+
+	weekdays, err := s.repo.FetchWeekdayCompletions(ctx, tenantID, queryRange.Start, queryRange.End)
+	if err != nil {
+		return nil, err
+	}
+
+	var totals TotalsStats
+	var totalTaskPoints, totalScheduled int
+	var totalDelay float64
+	for _, completion := range completions {
+		totals.Completed += completion.Completed
+		totals.OnTime += completion.OnTime
+		totals.Late += completion.Late()
+		totalTaskPoints += completion.TaskPoints
+		if completion.AvgDelaySeconds != nil {
+			totalScheduled += completion.Scheduled
+			totalDelay += *completion.AvgDelaySeconds * float64(completion.Scheduled)
+		}
+	}
+	for _, points := range pointsEarned {
+		totals.PointsEarned += points
+	}
+	totals.OnTimePercentage = percentage(totals.OnTime, totals.OnTime+totals.Late)
+	totals.LatePercentage = percentage(totals.Late, totals.OnTime+totals.Late)
+	if totalScheduled > 0 {
+		totals.AverageDelayMinutes = delayMinutes(totalDelay / float64(totalScheduled))
+	}
+
+	users := []UserStats{}
+	for _, completion := range completions {
+		if completion.Status != "active" && completion.Completed == 0 && pointsEarned[completion.UserID] == 0 {
+			continue
+		}
+
+		user := UserStats{
+			UserID:           completion.UserID,
+			Name:             completion.Name,
+			Completed:        completion.Completed,
+			PointsEarned:     pointsEarned[completion.UserID],
+			OnTime:           completion.OnTime,
+			Late:             completion.Late(),
+			OnTimePercentage: percentage(completion.OnTime, completion.Scheduled),
+			LatePercentage:   percentage(completion.Late(), completion.Scheduled),
+			CompletionShare:  percentage(completion.Completed, totals.Completed),
+			WorkShare:        percentage(completion.TaskPoints, totalTaskPoints),
+		}
+		if completion.AvgDelaySeconds != nil {
+			user.AverageDelayMinutes = delayMinutes(*completion.AvgDelaySeconds)
+		}
+
+		users = append(users, user)
+	}
+
+	return &StatsResponse{
+		Period:          period,
+		Start:           window.Start,
+		End:             window.End,
+		Totals:          totals,
+		Users:           users,
+		BusiestWeekdays: busiestWeekdays(weekdays),
+	}, nil
+}
+
+func busiestWeekdays(counts []domain.WeekdayCompletions) []WeekdayStats {
+	completed := make(map[time.Weekday]int, len(counts))
+	for _, count := range counts {
+		completed[count.Weekday] += count.Completed
+	}
+
+	weekdays := make([]time.Weekday, 0, 7)
+	for i := 1; i <= 7; i++ {
+		weekdays = append(weekdays, time.Weekday(i%7))
+	}
+
+	sort.SliceStable(weekdays, func(i, j int) bool {
+		return completed[weekdays[i]] > completed[weekdays[j]]
+	})
+
+	stats := make([]WeekdayStats, len(weekdays))
+	for i, weekday := range weekdays {
+		stats[i] = WeekdayStats{Weekday: weekday.String(), Completed: completed[weekday]}
+	}
+
+	return stats
+}
+
+func percentage(part int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return round(float64(part) * 100 / float64(total))
+}
+
+func delayMinutes(seconds float64) *float64 {
+	minutes := round(seconds / 60)
+	return &minutes
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,145 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"keep-your-house-clean/internal/stats/mocks"
+	"testing"
+	"time"
+)
+
+func createContext() context.Context {
+	ctx := middleware.SetUserIDInContext(context.Background(), 1)
+	return middleware.SetTenantIDInContext(ctx, 1)
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func TestService_GetStats(t *testing.T) {
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	from := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := &mocks.MockStatsRepository{
+		FetchUserCompletionsFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.UserCompletionStats, error) {
+			return []domain.UserCompletionStats{
+				{UserID: 1, Name: "Ana", Status: "active", Completed: 6, TaskPoints: 60, Scheduled: 4, OnTime: 3, AvgDelaySeconds: floatPtr(600)},
+				{UserID: 2, Name: "Bruno", Status: "active", Completed: 2, TaskPoints: 40, Scheduled: 1, OnTime: 0, AvgDelaySeconds: floatPtr(3600)},
+				{UserID: 3, Name: "Carla", Status: "active"},
+				{UserID: 4, Name: "Davi", Status: "inactive"},
+			}, nil
+		},
+		FetchPointsEarnedFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) (map[int64]int, error) {
+			return map[int64]int{1: 65, 2: 40}, nil
+		},
+		FetchWeekdayCompletionsFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.WeekdayCompletions, error) {
+			return []domain.WeekdayCompletions{
+				{Weekday: time.Sunday, Completed: 5},
+				{Weekday: time.Wednesday, Completed: 3},
+			}, nil
+		},
+	}
+
+	service := NewService(repo)
+	stats, err := service.GetStats(createContext(), StatsQuery{Now: now})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if stats.Period != domain.LeaderboardRolling30 {
+		t.Errorf("período padrão esperado %s, obtido %s", domain.LeaderboardRolling30, stats.Period)
+	}
+
+	totals := stats.Totals
+	if totals.Completed != 8 || totals.PointsEarned != 105 || totals.OnTime != 3 || totals.Late != 2 {
+		t.Errorf("totais incorretos: %+v", totals)
+	}
+	if totals.OnTimePercentage != 60 || totals.LatePercentage != 40 {
+		t.Errorf("percentuais esperados 60/40, obtidos %v/%v", totals.OnTimePercentage, totals.LatePercentage)
+	}
+	if totals.AverageDelayMinutes == nil || *totals.AverageDelayMinutes != 20 {
+		t.Errorf("atraso médio esperado 20 minutos, obtido %v", totals.AverageDelayMinutes)
+	}
+
+	if len(stats.Users) != 3 {
+		t.Fatalf("esperados 3 membros (inativo sem atividade omitido), obtidos %d", len(stats.Users))
+	}
+
+	ana := stats.Users[0]
+	if ana.CompletionShare != 75 || ana.WorkShare != 60 {
+		t.Errorf("participação esperada 75/60, obtida %v/%v", ana.CompletionShare, ana.WorkShare)
+	}
+	if ana.OnTimePercentage != 75 || ana.LatePercentage != 25 {
+		t.Errorf("percentuais de Ana esperados 75/25, obtidos %v/%v", ana.OnTimePercentage, ana.LatePercentage)
+	}
+	if ana.AverageDelayMinutes == nil || *ana.AverageDelayMinutes != 10 {
+		t.Errorf("atraso médio de Ana esperado 10 minutos, obtido %v", ana.AverageDelayMinutes)
+	}
+
+	carla := stats.Users[2]
+	if carla.AverageDelayMinutes != nil || carla.CompletionShare != 0 {
+		t.Errorf("membro sem conclusões não deveria ter atraso nem participação: %+v", carla)
+	}
+
+	if len(stats.BusiestWeekdays) != 7 {
+		t.Fatalf("esperados 7 dias da semana, obtidos %d", len(stats.BusiestWeekdays))
+	}
+	expectedOrder := []string{"Sunday", "Wednesday", "Monday"}
+	for i, weekday := range expectedOrder {
+		if stats.BusiestWeekdays[i].Weekday != weekday {
+			t.Errorf("posição %d: dia esperado %s, obtido %s", i, weekday, stats.BusiestWeekdays[i].Weekday)
+		}
+	}
+
+	_, err = service.GetStats(createContext(), StatsQuery{Period: domain.LeaderboardCustom, From: &from, Now: now})
+	if !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("esperado erro %v para intervalo incompleto, obtido %v", ErrInvalidRange, err)
+	}
+
+	_, err = service.GetStats(context.Background(), StatsQuery{Now: now})
+	if !errors.Is(err, ErrUserNotAuthenticated) {
+		t.Errorf("esperado erro %v sem autenticação, obtido %v", ErrUserNotAuthenticated, err)
+	}
+}
+
+func TestService_GetStats_QueriesInUTC(t *testing.T) {
+	location := time.FixedZone("BRT", -3*60*60)
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, location)
+
+	var queried []time.Time
+	repo := &mocks.MockStatsRepository{
+		FetchUserCompletionsFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.UserCompletionStats, error) {
+			queried = append(queried, from, to)
+			return nil, nil
+		},
+		FetchPointsEarnedFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) (map[int64]int, error) {
+			queried = append(queried, from, to)
+			return nil, nil
+		},
+		FetchWeekdayCompletionsFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.WeekdayCompletions, error) {
+			queried = append(queried, from, to)
+			return nil, nil
+		},
+	}
+
+	service := NewService(repo)
+	stats, err := service.GetStats(createContext(), StatsQuery{Period: domain.LeaderboardWeek, Now: now})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(queried) != 6 {
+		t.Fatalf("esperadas 3 consultas, obtidas %d", len(queried)/2)
+	}
+	for _, bound := range queried {
+		if bound.Location() != time.UTC {
+			t.Errorf("limite esperado em UTC, obtido %v", bound)
+		}
+	}
+	if !queried[0].Equal(stats.Start) || !queried[1].Equal(stats.End) {
+		t.Errorf("limites esperados %v-%v, obtidos %v-%v", stats.Start, stats.End, queried[0], queried[1])
+	}
+}
@@ -0,0 +1,226 @@
+package stream
+
+import (
+	"context"
+	"keep-your-house-clean/internal/events"
+	"sync"
+)
+
+const (
+	EventTypePointsChanged = "points.changed"
+	EventTypeResync        = "stream.resync"
+)
+
+type Message struct {
+	ID       int64
+	Type     string
+	TenantID int64
+	Data     interface{}
+}
+
+type Subscription struct {
+	tenantID int64
+	messages chan Message
+	done     chan struct{}
+	once     sync.Once
+}
+
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+func (s *Subscription) close() {
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
+
+type tenantStream struct {
+	subscribers map[*Subscription]struct{}
+	history     []Message
+	evictedUpTo int64
+}
+
+type Broker struct {
+	mu          sync.Mutex
+	firstID     int64
+	lastID      int64
+	tenants     map[int64]*tenantStream
+	historySize int
+	bufferSize  int
+}
+
+func NewBroker(historySize int, bufferSize int) *Broker {
+	return &Broker{
+		tenants:     make(map[int64]*tenantStream),
+		historySize: historySize,
+		bufferSize:  bufferSize,
+	}
+}
+
+func (b *Broker) Subscribe(tenantID int64, lastEventID int64) (*Subscription, []Message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stream := b.tenant(tenantID)
+	sub := &Subscription{
+		tenantID: tenantID,
+		messages: make(chan Message, b.bufferSize),
+		done:     make(chan struct{}),
+	}
+	stream.subscribers[sub] = struct{}{}
+
+	if lastEventID == 0 {
+		return sub, nil
+	}
+
+	if b.firstID == 0 || lastEventID < b.firstID || lastEventID > b.lastID || lastEventID < stream.evictedUpTo {
+		return sub, []Message{{ID: b.lastID, Type: EventTypeResync, TenantID: tenantID}}
+	}
+
+	var replay []Message
+	for _, message := range stream.history {
+		if message.ID > lastEventID {
+			replay = append(replay, message)
+		}
+	}
+
+	return sub, replay
+}
+
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if stream, ok := b.tenants[sub.tenantID]; ok {
+		delete(stream.subscribers, sub)
+	}
+	sub.close()
+}
+
+func (b *Broker) Publish(tenantID int64, id int64, eventType string, data interface{}) Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.firstID == 0 {
+		b.firstID = id
+	}
+	if id > b.lastID {
+		b.lastID = id
+	}
+	message := Message{
+		ID:       id,
+		Type:     eventType,
+		TenantID: tenantID,
+		Data:     data,
+	}
+
+	stream := b.tenant(tenantID)
+	stream.history = append(stream.history, message)
+	if len(stream.history) > b.historySize {
+		evicted := len(stream.history) - b.historySize
+		for _, old := range stream.history[:evicted] {
+			if old.ID > stream.evictedUpTo {
+				stream.evictedUpTo = old.ID
+			}
+		}
+		stream.history = append([]Message(nil), stream.history[evicted:]...)
+	}
+
+	for sub := range stream.subscribers {
+		select {
+		case sub.messages <- message:
+		default:
+			delete(stream.subscribers, sub)
+			sub.close()
+		}
+	}
+
+	return message
+}
+
+func (b *Broker) Handle(ctx context.Context, event events.Event) error {
+	switch payload := event.Payload.(type) {
+	case events.TaskCompletedPayload:
+		b.Publish(payload.TenantID, event.ID, string(event.Type), taskCompletedData{
+			TaskID:      payload.TaskID,
+			TaskTitle:   payload.TaskTitle,
+			CompletedBy: payload.CompletedBy,
+			Points:      payload.Points,
+		})
+		b.publishPointsChanged(payload.TenantID, event.ID, payload.CompletedBy, payload.Points)
+	case events.TaskUndonePayload:
+		b.Publish(payload.TenantID, event.ID, string(event.Type), taskCompletedData{
+			TaskID:      payload.TaskID,
+			TaskTitle:   payload.TaskTitle,
+			CompletedBy: payload.CompletedBy,
+			Points:      payload.Points,
+		})
+		b.publishPointsChanged(payload.TenantID, event.ID, payload.CompletedBy, -payload.Points)
+	case events.ComplimentReceivedPayload:
+		b.Publish(payload.TenantID, event.ID, string(event.Type), complimentReceivedData{
+			ComplimentID: payload.ComplimentID,
+			Title:        payload.Title,
+			FromUserID:   payload.FromUser,
+			ToUserID:     payload.ToUser,
+			Points:       payload.Points,
+		})
+		b.publishPointsChanged(payload.TenantID, event.ID, payload.ToUser, payload.Points)
+	case events.AchievementUnlockedPayload:
+		b.Publish(payload.TenantID, event.ID, string(event.Type), achievementUnlockedData{
+			AchievementID: payload.AchievementID,
+			Code:          payload.Code,
+			Name:          payload.Name,
+			UserID:        payload.UserID,
+		})
+	}
+
+	return nil
+}
+
+func (b *Broker) publishPointsChanged(tenantID int64, id int64, userID int64, delta int) {
+	if delta == 0 {
+		return
+	}
+	b.Publish(tenantID, id, EventTypePointsChanged, pointsChangedData{UserID: userID, Delta: delta})
+}
+
+func (b *Broker) tenant(tenantID int64) *tenantStream {
+	stream, ok := b.tenants[tenantID]
+	if !ok {
+		stream = &tenantStream{subscribers: make(map[*Subscription]struct{})}
+		b.tenants[tenantID] = stream
+	}
+	return stream
+}
+
+type taskCompletedData struct {
+	TaskID      int64  `json:"task_id"`
+	TaskTitle   string `json:"task_title"`
+	CompletedBy int64  `json:"completed_by"`
+	Points      int    `json:"points"`
+}
+
+type complimentReceivedData struct {
+	ComplimentID int64  `json:"compliment_id"`
+	Title        string `json:"title"`
+	FromUserID   int64  `json:"from_user_id"`
+	ToUserID     int64  `json:"to_user_id"`
+	Points       int    `json:"points"`
+}
+
+type achievementUnlockedData struct {
+	AchievementID int64  `json:"achievement_id"`
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	UserID        int64  `json:"user_id"`
+}
+
+type pointsChangedData struct {
+	UserID int64 `json:"user_id"`
+	Delta  int   `json:"delta"`
+}
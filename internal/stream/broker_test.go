@@ -0,0 +1,190 @@
+package stream
+
+import (
+	"context"
+	"keep-your-house-clean/internal/events"
+	"keep-your-house-clean/internal/platform/middleware"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBroker_PublishIsTenantScoped(t *testing.T) {
+	broker := NewBroker(10, 10)
+	sub1, _ := broker.Subscribe(1, 0)
+	sub2, _ := broker.Subscribe(2, 0)
+
+	broker.Publish(1, 1, "task.completed", nil)
+
+	select {
+	case message := <-sub1.Messages():
+		if message.TenantID != 1 {
+			t.Errorf("tenant esperado 1, obtido %d", message.TenantID)
+		}
+	default:
+		t.Fatal("assinante do tenant 1 deveria receber a mensagem")
+	}
+
+	select {
+	case <-sub2.Messages():
+		t.Fatal("assinante do tenant 2 não deveria receber a mensagem")
+	default:
+	}
+}
+
+func TestBroker_SubscribeReplay(t *testing.T) {
+	broker := NewBroker(3, 10)
+	first := broker.Publish(1, 10, "a", nil)
+	broker.Publish(1, 11, "b", nil)
+	broker.Publish(2, 12, "other", nil)
+	third := broker.Publish(1, 13, "c", nil)
+
+	tests := []struct {
+		name          string
+		lastEventID   int64
+		expectedTypes []string
+	}{
+		{
+			name:          "sem Last-Event-ID não reenvia nada",
+			lastEventID:   0,
+			expectedTypes: nil,
+		},
+		{
+			name:          "reenvia eventos posteriores ao último recebido",
+			lastEventID:   first.ID,
+			expectedTypes: []string{"b", "c"},
+		},
+		{
+			name:          "nada a reenviar quando está em dia",
+			lastEventID:   third.ID,
+			expectedTypes: nil,
+		},
+		{
+			name:          "ID anterior ao início do broker pede ressincronização",
+			lastEventID:   first.ID - 1,
+			expectedTypes: []string{EventTypeResync},
+		},
+		{
+			name:          "ID ainda não recebido por esta instância pede ressincronização",
+			lastEventID:   third.ID + 100,
+			expectedTypes: []string{EventTypeResync},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub, replay := broker.Subscribe(1, tt.lastEventID)
+			defer broker.Unsubscribe(sub)
+
+			if len(replay) != len(tt.expectedTypes) {
+				t.Fatalf("mensagens esperadas %v, obtidas %v", tt.expectedTypes, replay)
+			}
+			for i, message := range replay {
+				if message.Type != tt.expectedTypes[i] {
+					t.Errorf("tipo esperado %s, obtido %s", tt.expectedTypes[i], message.Type)
+				}
+			}
+		})
+	}
+}
+
+func TestBroker_HistoryEvictionRequiresResync(t *testing.T) {
+	broker := NewBroker(2, 10)
+	first := broker.Publish(1, 1, "a", nil)
+	broker.Publish(1, 2, "b", nil)
+	broker.Publish(1, 3, "c", nil)
+	broker.Publish(1, 4, "d", nil)
+
+	_, replay := broker.Subscribe(1, first.ID)
+	if len(replay) != 1 || replay[0].Type != EventTypeResync {
+		t.Errorf("esperava ressincronização, obtido %v", replay)
+	}
+}
+
+func TestBroker_SubscribeBeforeAnyEventRequiresResync(t *testing.T) {
+	broker := NewBroker(10, 10)
+
+	_, replay := broker.Subscribe(1, 42)
+	if len(replay) != 1 || replay[0].Type != EventTypeResync {
+		t.Errorf("esperava ressincronização, obtido %v", replay)
+	}
+}
+
+func TestBroker_SlowSubscriberIsDropped(t *testing.T) {
+	broker := NewBroker(10, 1)
+	sub, _ := broker.Subscribe(1, 0)
+
+	broker.Publish(1, 1, "a", nil)
+	broker.Publish(1, 2, "b", nil)
+
+	select {
+	case <-sub.Done():
+	default:
+		t.Fatal("assinante lento deveria ser desconectado")
+	}
+
+	broker.Publish(1, 3, "c", nil)
+	if len(sub.Messages()) != 1 {
+		t.Errorf("assinante desconectado não deveria receber novas mensagens, buffer com %d", len(sub.Messages()))
+	}
+}
+
+func TestBroker_Handle(t *testing.T) {
+	broker := NewBroker(10, 10)
+	sub, _ := broker.Subscribe(1, 0)
+
+	broker.Handle(context.Background(), events.Event{
+		ID:      7,
+		Type:    events.EventTypeTaskCompleted,
+		Payload: events.TaskCompletedPayload{TaskID: 1, CompletedBy: 2, TenantID: 1, Points: 3},
+	})
+
+	expected := []string{string(events.EventTypeTaskCompleted), EventTypePointsChanged}
+	for _, eventType := range expected {
+		select {
+		case message := <-sub.Messages():
+			if message.Type != eventType {
+				t.Errorf("tipo esperado %s, obtido %s", eventType, message.Type)
+			}
+			if message.ID != 7 {
+				t.Errorf("ID esperado 7 (ID do evento no outbox), obtido %d", message.ID)
+			}
+		default:
+			t.Fatalf("esperava mensagem %s", eventType)
+		}
+	}
+}
+
+func TestHandler_Stream(t *testing.T) {
+	broker := NewBroker(10, 10)
+	first := broker.Publish(1, 1, "task.completed", map[string]int{"task_id": 1})
+	broker.Publish(1, 2, "task.undone", map[string]int{"task_id": 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	ctx = middleware.SetUserIDInContext(ctx, 1)
+	ctx = middleware.SetTenantIDInContext(ctx, 1)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/stream", nil).WithContext(ctx)
+	req.Header.Set("Last-Event-ID", strconv.FormatInt(first.ID, 10))
+	rec := httptest.NewRecorder()
+
+	NewHandler(broker, 10*time.Millisecond).Stream(rec, req)
+
+	body := rec.Body.String()
+	if rec.Header().Get("Content-Type") != "text/event-stream" {
+		t.Errorf("Content-Type inesperado: %s", rec.Header().Get("Content-Type"))
+	}
+	if strings.Contains(body, "event: task.completed") {
+		t.Error("evento já recebido não deveria ser reenviado")
+	}
+	if !strings.Contains(body, "event: task.undone") {
+		t.Errorf("evento posterior deveria ser reenviado, corpo: %s", body)
+	}
+	if !strings.Contains(body, ": heartbeat") {
+		t.Errorf("esperava heartbeat, corpo: %s", body)
+	}
+}
@@ -0,0 +1,380 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"strings"
+	"time"
+)
+
+func (s *Service) GetChecklist(ctx context.Context, taskID int64) (*ChecklistResponse, error) {
+	task, err := s.checklistTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.checklistRepo.FetchByTask(ctx, task.ID, task.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildChecklistResponse(task.ID, items), nil
+}
+
+func (s *Service) AddChecklistItem(ctx context.Context, taskID int64, req ChecklistItemRequest) (*domain.ChecklistItem, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.ChecklistItem, error) {
+		userID := middleware.GetUserIDFromContext(ctx)
+
+		task, err := s.editableChecklistTask(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		title := strings.TrimSpace(req.Title)
+		if title == "" {
+			return nil, ErrInvalidChecklistItem
+		}
+
+		items, err := s.checklistRepo.FetchByTask(ctx, task.ID, task.TenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		index := len(items)
+		if req.Position != nil {
+			if *req.Position < 1 || *req.Position > len(items)+1 {
+				return nil, ErrInvalidChecklistItem
+			}
+			index = *req.Position - 1
+		}
+
+		now := time.Now()
+		item := &domain.ChecklistItem{
+			TenantID:    task.TenantID,
+			TaskID:      task.ID,
+			Position:    index + 1,
+			Title:       title,
+			CreatedAt:   now,
+			CreatedById: &userID,
+			UpdatedAt:   now,
+		}
+
+		if err := s.renumberChecklist(ctx, items[index:], index+2, now); err != nil {
+			return nil, err
+		}
+
+		if err := s.checklistRepo.Create(ctx, item); err != nil {
+			return nil, err
+		}
+
+		return item, nil
+	})
+}
+
+func (s *Service) UpdateChecklistItem(ctx context.Context, taskID int64, itemID int64, req UpdateChecklistItemRequest) (*domain.ChecklistItem, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.ChecklistItem, error) {
+		task, err := s.editableChecklistTask(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := s.checklistRepo.FetchByTask(ctx, task.ID, task.TenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		index := checklistIndex(items, itemID)
+		if index < 0 {
+			return nil, ErrChecklistItemNotFound
+		}
+
+		item := items[index]
+		now := time.Now()
+
+		if req.Title != nil {
+			title := strings.TrimSpace(*req.Title)
+			if title == "" {
+				return nil, ErrInvalidChecklistItem
+			}
+			item.Title = title
+		}
+
+		if req.Position != nil && *req.Position != index+1 {
+			if *req.Position < 1 || *req.Position > len(items) {
+				return nil, ErrInvalidChecklistItem
+			}
+
+			reordered := append(append([]domain.ChecklistItem{}, items[:index]...), items[index+1:]...)
+			target := *req.Position - 1
+			reordered = append(reordered[:target], append([]domain.ChecklistItem{item}, reordered[target:]...)...)
+
+			if err := s.renumberChecklist(ctx, reordered, 1, now); err != nil {
+				return nil, err
+			}
+			return &reordered[target], nil
+		}
+
+		item.UpdatedAt = now
+		if err := s.checklistRepo.Update(ctx, &item); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrChecklistItemNotFound
+			}
+			return nil, err
+		}
+
+		return &item, nil
+	})
+}
+
+func (s *Service) DeleteChecklistItem(ctx context.Context, taskID int64, itemID int64) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		task, err := s.editableChecklistTask(ctx, taskID)
+		if err != nil {
+			return err
+		}
+
+		items, err := s.checklistRepo.FetchByTask(ctx, task.ID, task.TenantID)
+		if err != nil {
+			return err
+		}
+
+		index := checklistIndex(items, itemID)
+		if index < 0 {
+			return ErrChecklistItemNotFound
+		}
+
+		if err := s.checklistRepo.Delete(ctx, itemID, task.TenantID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrChecklistItemNotFound
+			}
+			return err
+		}
+
+		return s.renumberChecklist(ctx, items[index+1:], index+1, time.Now())
+	})
+}
+
+func (s *Service) ReorderChecklist(ctx context.Context, taskID int64, req ReorderChecklistRequest) (*ChecklistResponse, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*ChecklistResponse, error) {
+		task, err := s.editableChecklistTask(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		items, err := s.checklistRepo.FetchByTask(ctx, task.ID, task.TenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(req.ItemIDs) != len(items) {
+			return nil, ErrInvalidChecklistOrder
+		}
+
+		reordered := make([]domain.ChecklistItem, 0, len(items))
+		seen := make(map[int64]bool, len(req.ItemIDs))
+		for _, itemID := range req.ItemIDs {
+			index := checklistIndex(items, itemID)
+			if index < 0 || seen[itemID] {
+				return nil, ErrInvalidChecklistOrder
+			}
+			seen[itemID] = true
+			reordered = append(reordered, items[index])
+		}
+
+		if err := s.renumberChecklist(ctx, reordered, 1, time.Now()); err != nil {
+			return nil, err
+		}
+
+		return buildChecklistResponse(task.ID, reordered), nil
+	})
+}
+
+func (s *Service) CheckChecklistItem(ctx context.Context, taskID int64, itemID int64) (*domain.ChecklistItem, error) {
+	return s.setChecklistItemChecked(ctx, taskID, itemID, true)
+}
+
+func (s *Service) UncheckChecklistItem(ctx context.Context, taskID int64, itemID int64) (*domain.ChecklistItem, error) {
+	return s.setChecklistItemChecked(ctx, taskID, itemID, false)
+}
+
+func (s *Service) GetChecklistAudit(ctx context.Context, taskID int64, limit int, offset int) ([]domain.ChecklistAuditEntry, error) {
+	task, err := s.checklistTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.checklistRepo.FetchAudit(ctx, task.ID, task.TenantID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if entries == nil {
+		entries = []domain.ChecklistAuditEntry{}
+	}
+
+	return entries, nil
+}
+
+func (s *Service) setChecklistItemChecked(ctx context.Context, taskID int64, itemID int64, checked bool) (*domain.ChecklistItem, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.ChecklistItem, error) {
+		userID := middleware.GetUserIDFromContext(ctx)
+
+		task, err := s.editableChecklistTask(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		item, err := s.checklistRepo.GetByID(ctx, itemID, task.TenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		if item == nil || item.TaskID != task.ID {
+			return nil, ErrChecklistItemNotFound
+		}
+
+		if item.IsChecked() == checked {
+			return item, nil
+		}
+
+		now := time.Now()
+		action := domain.ChecklistUnchecked
+		item.CheckedAt = nil
+		item.CheckedById = nil
+		if checked {
+			action = domain.ChecklistChecked
+			item.CheckedAt = &now
+			item.CheckedById = &userID
+		}
+		item.UpdatedAt = now
+
+		if err := s.checklistRepo.Update(ctx, item); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrChecklistItemNotFound
+			}
+			return nil, err
+		}
+
+		err = s.checklistRepo.RecordAudit(ctx, &domain.ChecklistAuditEntry{
+			TenantID:  task.TenantID,
+			TaskID:    task.ID,
+			ItemID:    &item.ID,
+			ItemTitle: item.Title,
+			UserID:    &userID,
+			Action:    action,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return item, nil
+	})
+}
+
+func (s *Service) copyChecklist(ctx context.Context, previous *domain.Task, next *domain.Task) error {
+	if previous.ID == 0 {
+		return nil
+	}
+
+	items, err := s.checklistRepo.FetchByTask(ctx, previous.ID, previous.TenantID)
+	if err != nil {
+		return err
+	}
+
+	copies := domain.CopyChecklist(items, next.ID, &next.CreatedById, next.CreatedAt)
+	for i := range copies {
+		if err := s.checklistRepo.Create(ctx, &copies[i]); err != nil {
+			return err
+		}
+	}
+
+	if len(copies) > 0 {
+		next.Checklist = copies
+	}
+
+	return nil
+}
+
+func (s *Service) checklistTask(ctx context.Context, taskID int64) (*domain.Task, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	task, err := s.repo.GetByID(ctx, taskID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task == nil {
+		return nil, ErrTaskNotFound
+	}
+
+	return task, nil
+}
+
+func (s *Service) editableChecklistTask(ctx context.Context, taskID int64) (*domain.Task, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if userID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	task, err := s.repo.GetByIDForUpdate(ctx, taskID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task == nil {
+		return nil, ErrTaskNotFound
+	}
+
+	if task.Completed {
+		return nil, ErrTaskAlreadyCompleted
+	}
+
+	return task, nil
+}
+
+func (s *Service) renumberChecklist(ctx context.Context, items []domain.ChecklistItem, firstPosition int, now time.Time) error {
+	for i := range items {
+		position := firstPosition + i
+		if items[i].Position == position {
+			continue
+		}
+
+		items[i].Position = position
+		items[i].UpdatedAt = now
+		if err := s.checklistRepo.Update(ctx, &items[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checklistIndex(items []domain.ChecklistItem, itemID int64) int {
+	for i := range items {
+		if items[i].ID == itemID {
+			return i
+		}
+	}
+	return -1
+}
+
+func buildChecklistResponse(taskID int64, items []domain.ChecklistItem) *ChecklistResponse {
+	if items == nil {
+		items = []domain.ChecklistItem{}
+	}
+
+	return &ChecklistResponse{
+		TaskID:   taskID,
+		Items:    items,
+		Progress: domain.ProgressOf(items),
+	}
+}
@@ -14,6 +14,10 @@ type CreateTaskRequest struct {
 	ScheduledById  *int64                 `json:"scheduled_by_id"`
 	FrequencyValue int                    `json:"frequency_value"`
 	FrequencyUnit  domain.FrequencyUnit   `json:"frequency_unit"`
+	AssigneeID     *int64                 `json:"assignee_id"`
+	Rotation       *RotationRequest       `json:"rotation"`
+	RecurrenceRule *string                `json:"recurrence_rule"`
+	AnchorMode     domain.RecurrenceAnchor `json:"anchor_mode"`
 }
 
 type UpdateTaskRequest struct {
@@ -27,8 +31,51 @@ type UpdateTaskRequest struct {
 	FrequencyUnit  *domain.FrequencyUnit  `json:"frequency_unit"`
 	Completed      *bool                  `json:"completed"`
 	CompletedAt    *time.Time             `json:"completed_at"`
+	AssigneeID     *int64                 `json:"assignee_id"`
+	Rotation       *RotationRequest       `json:"rotation"`
+	RecurrenceRule *string                `json:"recurrence_rule"`
+	AnchorMode     *domain.RecurrenceAnchor `json:"anchor_mode"`
 }
 
 type CompleteTaskRequest struct {
 	CompletedById *int64 `json:"completed_by_id"`
 }
+
+type RotationRequest struct {
+	Strategy  domain.RotationStrategy `json:"strategy"`
+	MemberIDs []int64                 `json:"member_ids"`
+}
+
+type UpdateSeriesRequest struct {
+	Title          *string               `json:"title"`
+	Description    *string               `json:"description"`
+	Points         *int                  `json:"points"`
+	FrequencyValue *int                  `json:"frequency_value"`
+	FrequencyUnit  *domain.FrequencyUnit `json:"frequency_unit"`
+	RecurrenceRule *string               `json:"recurrence_rule"`
+	AnchorMode     *domain.RecurrenceAnchor `json:"anchor_mode"`
+}
+
+type TaskFilter struct {
+	AssigneeID *int64
+}
+
+type ChecklistItemRequest struct {
+	Title    string `json:"title"`
+	Position *int   `json:"position"`
+}
+
+type UpdateChecklistItemRequest struct {
+	Title    *string `json:"title"`
+	Position *int    `json:"position"`
+}
+
+type ReorderChecklistRequest struct {
+	ItemIDs []int64 `json:"item_ids"`
+}
+
+type ChecklistResponse struct {
+	TaskID   int64                    `json:"task_id"`
+	Items    []domain.ChecklistItem   `json:"items"`
+	Progress domain.ChecklistProgress `json:"progress"`
+}
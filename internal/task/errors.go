@@ -7,5 +7,17 @@ var (
 	ErrTaskNotFound                = errors.New("task not found")
 	ErrTaskAlreadyCompleted        = errors.New("task already completed")
 	ErrTaskNotCompleted            = errors.New("task is not completed")
+	ErrNextOccurrenceCompleted     = errors.New("the next occurrence of this task is already completed")
+	ErrCompletionNotEditable       = errors.New("use the complete and undo endpoints to change a task's completion")
 	ErrFrequencyNotDefined         = errors.New("frequency unit or frequency value not defined")
+	ErrInvalidAssignee             = errors.New("assignee must be an active member of the household")
+	ErrAssigneeUnavailable         = errors.New("assignee is unavailable on the task's scheduled date")
+	ErrNotTaskAssignee             = errors.New("only the assignee can complete this task")
+	ErrInvalidRotation             = errors.New("rotation requires a valid strategy and at least one member")
+	ErrRotationNotFound            = errors.New("task has no rotation")
+	ErrSeriesNotFound              = errors.New("task series not found")
+	ErrInvalidAnchorMode           = errors.New("anchor mode must be completion or schedule")
+	ErrChecklistItemNotFound       = errors.New("checklist item not found")
+	ErrInvalidChecklistItem        = errors.New("checklist item requires a title and a position within the checklist")
+	ErrInvalidChecklistOrder       = errors.New("item_ids must list every checklist item exactly once")
 )
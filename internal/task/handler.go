@@ -7,6 +7,8 @@ import (
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
 )
 
 type Handler struct {
@@ -24,11 +26,32 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 		r.Get("/history", h.GetCompletedTasksHistory)
 		r.Get("/user/{userId}/completed", h.GetCompletedTasksByUser)
 		r.Get("/{id}", h.GetTask)
+		r.Get("/{id}/rotation", h.GetTaskRotation)
+		r.Get("/{id}/streak", h.GetTaskStreak)
+		r.Get("/{id}/checklist", h.GetChecklist)
+		r.Get("/{id}/checklist/audit", h.GetChecklistAudit)
+		r.Post("/{id}/checklist", h.AddChecklistItem)
+		r.Put("/{id}/checklist/order", h.ReorderChecklist)
+		r.Put("/{id}/checklist/{itemId}", h.UpdateChecklistItem)
+		r.Delete("/{id}/checklist/{itemId}", h.DeleteChecklistItem)
+		r.Post("/{id}/checklist/{itemId}/check", h.CheckChecklistItem)
+		r.Post("/{id}/checklist/{itemId}/uncheck", h.UncheckChecklistItem)
 		r.Post("/", h.CreateTask)
 		r.Put("/{id}", h.UpdateTask)
 		r.Post("/{id}/complete", h.CompleteTask)
 		r.Post("/{id}/undo", h.UndoCompleteTask)
-		r.Delete("/{id}", h.DeleteTask)
+		r.With(middleware.RequirePermission(middleware.PermissionManageTasks)).Delete("/{id}", h.DeleteTask)
+	})
+
+	r.Route("/api/v1/series", func(r chi.Router) {
+		r.Get("/", h.ListSeries)
+		r.Get("/{id}", h.GetSeries)
+		r.Get("/{id}/history", h.GetSeriesHistory)
+		r.Get("/{id}/streak", h.GetSeriesStreak)
+		r.Put("/{id}", h.UpdateSeries)
+		r.Post("/{id}/pause", h.PauseSeries)
+		r.Post("/{id}/resume", h.ResumeSeries)
+		r.With(middleware.RequirePermission(middleware.PermissionManageTasks)).Delete("/{id}", h.DeleteSeries)
 	})
 }
 
@@ -41,6 +64,26 @@ func (h *Handler) CreateTask(w http.ResponseWriter, r *http.Request) {
 
 	task, err := h.service.CreateTask(r.Context(), req)
 	if err != nil {
+		if errors.Is(err, ErrInvalidAssignee) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, ErrAssigneeUnavailable) {
+			respondWithError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidRotation) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidRecurrenceRule) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidAnchorMode) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		if errors.Is(err, ErrUserNotAuthenticated) {
 			respondWithError(w, http.StatusUnauthorized, err.Error())
 			return
@@ -77,8 +120,39 @@ func (h *Handler) GetTask(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusOK, task)
 }
 
+func (h *Handler) GetTaskRotation(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID")
+		return
+	}
+
+	rotation, err := h.service.GetTaskRotation(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrTaskNotFound) || errors.Is(err, ErrRotationNotFound) {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, rotation)
+}
+
 func (h *Handler) ListTasks(w http.ResponseWriter, r *http.Request) {
-	tasks, err := h.service.ListTasks(r.Context())
+	filter, err := parseTaskFilter(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tasks, err := h.service.ListTasks(r.Context(), filter)
 	if err != nil {
 		if errors.Is(err, ErrUserNotAuthenticated) {
 			respondWithError(w, http.StatusUnauthorized, err.Error())
@@ -111,6 +185,34 @@ func (h *Handler) UpdateTask(w http.ResponseWriter, r *http.Request) {
 			respondWithError(w, http.StatusNotFound, err.Error())
 			return
 		}
+		if middleware.IsForbidden(err) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, ErrCompletionNotEditable) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidAssignee) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, ErrAssigneeUnavailable) {
+			respondWithError(w, http.StatusConflict, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidRotation) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidRecurrenceRule) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidAnchorMode) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		if errors.Is(err, ErrUserNotAuthenticated) {
 			respondWithError(w, http.StatusUnauthorized, err.Error())
 			return
@@ -140,6 +242,14 @@ func (h *Handler) CompleteTask(w http.ResponseWriter, r *http.Request) {
 
 	task, err := h.service.CompleteTask(r.Context(), id, req)
 	if err != nil {
+		if middleware.IsForbidden(err) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, ErrNotTaskAssignee) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
 		if errors.Is(err, ErrTaskNotFound) {
 			respondWithError(w, http.StatusNotFound, err.Error())
 			return
@@ -168,6 +278,10 @@ func (h *Handler) DeleteTask(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteTask(r.Context(), id); err != nil {
+		if middleware.IsForbidden(err) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
 		if errors.Is(err, ErrTaskNotFound) {
 			respondWithError(w, http.StatusNotFound, err.Error())
 			return
@@ -202,7 +316,13 @@ func (h *Handler) GetUpcomingTasks(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	tasks, err := h.service.GetUpcomingTasks(r.Context(), limit, offset)
+	filter, err := parseTaskFilter(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tasks, err := h.service.GetUpcomingTasks(r.Context(), filter, limit, offset)
 	if err != nil {
 		if errors.Is(err, ErrUserNotAuthenticated) {
 			respondWithError(w, http.StatusUnauthorized, err.Error())
@@ -287,6 +407,10 @@ func (h *Handler) UndoCompleteTask(w http.ResponseWriter, r *http.Request) {
 
 	task, err := h.service.UndoCompleteTask(r.Context(), id)
 	if err != nil {
+		if middleware.IsForbidden(err) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
 		if errors.Is(err, ErrTaskNotFound) {
 			respondWithError(w, http.StatusNotFound, err.Error())
 			return
@@ -295,6 +419,10 @@ func (h *Handler) UndoCompleteTask(w http.ResponseWriter, r *http.Request) {
 			respondWithError(w, http.StatusBadRequest, err.Error())
 			return
 		}
+		if errors.Is(err, ErrNextOccurrenceCompleted) {
+			respondWithError(w, http.StatusConflict, err.Error())
+			return
+		}
 		if errors.Is(err, ErrUserNotAuthenticated) {
 			respondWithError(w, http.StatusUnauthorized, err.Error())
 			return
@@ -306,6 +434,393 @@ func (h *Handler) UndoCompleteTask(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusOK, task)
 }
 
+func (h *Handler) ListSeries(w http.ResponseWriter, r *http.Request) {
+	series, err := h.service.ListSeries(r.Context())
+	if err != nil {
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, series)
+}
+
+func (h *Handler) GetSeries(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	series, err := h.service.GetSeries(r.Context(), id)
+	if err != nil {
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, series)
+}
+
+func (h *Handler) UpdateSeries(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	var req UpdateSeriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	series, err := h.service.UpdateSeries(r.Context(), id, req)
+	if err != nil {
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, series)
+}
+
+func (h *Handler) PauseSeries(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	series, err := h.service.PauseSeries(r.Context(), id)
+	if err != nil {
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, series)
+}
+
+func (h *Handler) ResumeSeries(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	series, err := h.service.ResumeSeries(r.Context(), id)
+	if err != nil {
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, series)
+}
+
+func (h *Handler) DeleteSeries(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	if err := h.service.DeleteSeries(r.Context(), id); err != nil {
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) GetSeriesHistory(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	limit := 20
+	offset := 0
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	tasks, err := h.service.GetSeriesHistory(r.Context(), id, limit, offset)
+	if err != nil {
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, tasks)
+}
+
+func (h *Handler) GetTaskStreak(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID")
+		return
+	}
+
+	streak, err := h.service.GetTaskStreak(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrTaskNotFound) {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, streak)
+}
+
+func (h *Handler) GetSeriesStreak(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	streak, err := h.service.GetSeriesStreak(r.Context(), id)
+	if err != nil {
+		respondWithSeriesError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, streak)
+}
+
+func (h *Handler) GetChecklist(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID")
+		return
+	}
+
+	checklist, err := h.service.GetChecklist(r.Context(), id)
+	if err != nil {
+		respondWithChecklistError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, checklist)
+}
+
+func (h *Handler) GetChecklistAudit(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID")
+		return
+	}
+
+	limit := 50
+	offset := 0
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	entries, err := h.service.GetChecklistAudit(r.Context(), id, limit, offset)
+	if err != nil {
+		respondWithChecklistError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, entries)
+}
+
+func (h *Handler) AddChecklistItem(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID")
+		return
+	}
+
+	var req ChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	item, err := h.service.AddChecklistItem(r.Context(), id, req)
+	if err != nil {
+		respondWithChecklistError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, item)
+}
+
+func (h *Handler) ReorderChecklist(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID")
+		return
+	}
+
+	var req ReorderChecklistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	checklist, err := h.service.ReorderChecklist(r.Context(), id, req)
+	if err != nil {
+		respondWithChecklistError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, checklist)
+}
+
+func (h *Handler) UpdateChecklistItem(w http.ResponseWriter, r *http.Request) {
+	id, itemID, ok := parseChecklistParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	item, err := h.service.UpdateChecklistItem(r.Context(), id, itemID, req)
+	if err != nil {
+		respondWithChecklistError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, item)
+}
+
+func (h *Handler) DeleteChecklistItem(w http.ResponseWriter, r *http.Request) {
+	id, itemID, ok := parseChecklistParams(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteChecklistItem(r.Context(), id, itemID); err != nil {
+		respondWithChecklistError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) CheckChecklistItem(w http.ResponseWriter, r *http.Request) {
+	id, itemID, ok := parseChecklistParams(w, r)
+	if !ok {
+		return
+	}
+
+	item, err := h.service.CheckChecklistItem(r.Context(), id, itemID)
+	if err != nil {
+		respondWithChecklistError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, item)
+}
+
+func (h *Handler) UncheckChecklistItem(w http.ResponseWriter, r *http.Request) {
+	id, itemID, ok := parseChecklistParams(w, r)
+	if !ok {
+		return
+	}
+
+	item, err := h.service.UncheckChecklistItem(r.Context(), id, itemID)
+	if err != nil {
+		respondWithChecklistError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, item)
+}
+
+func parseChecklistParams(w http.ResponseWriter, r *http.Request) (int64, int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid task ID")
+		return 0, 0, false
+	}
+
+	itemID, err := strconv.ParseInt(chi.URLParam(r, "itemId"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid checklist item ID")
+		return 0, 0, false
+	}
+
+	return id, itemID, true
+}
+
+func respondWithChecklistError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrUserNotAuthenticated):
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, ErrTaskNotFound), errors.Is(err, ErrChecklistItemNotFound):
+		respondWithError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrInvalidChecklistItem), errors.Is(err, ErrInvalidChecklistOrder):
+		respondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrTaskAlreadyCompleted):
+		respondWithError(w, http.StatusConflict, err.Error())
+	default:
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func respondWithSeriesError(w http.ResponseWriter, err error) {
+	switch {
+	case middleware.IsForbidden(err):
+		respondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ErrSeriesNotFound):
+		respondWithError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrFrequencyNotDefined), errors.Is(err, ErrInvalidAnchorMode), errors.Is(err, domain.ErrInvalidRecurrenceRule):
+		respondWithError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrUserNotAuthenticated):
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+	default:
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func parseTaskFilter(r *http.Request) (TaskFilter, error) {
+	filter := TaskFilter{}
+
+	assignee := r.URL.Query().Get("assignee")
+	if assignee == "" {
+		return filter, nil
+	}
+
+	if assignee == "me" {
+		userID := middleware.GetUserIDFromContext(r.Context())
+		filter.AssigneeID = &userID
+		return filter, nil
+	}
+
+	assigneeID, err := strconv.ParseInt(assignee, 10, 64)
+	if err != nil {
+		return filter, errors.New("Invalid assignee filter")
+	}
+	filter.AssigneeID = &assigneeID
+
+	return filter, nil
+}
+
 func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -4,16 +4,26 @@ import (
 	"context"
 	"keep-your-house-clean/internal/domain"
 	"keep-your-house-clean/internal/events"
+	"time"
 )
 
 type MockTaskRepository struct {
 	CreateFunc                func(ctx context.Context, task *domain.Task) error
 	FetchAllFunc              func(ctx context.Context, tenantID int64) ([]domain.Task, error)
 	GetByIDFunc               func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error)
+	GetByIDForUpdateFunc      func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error)
 	UpdateFunc                func(ctx context.Context, task *domain.Task) error
 	DeleteFunc                func(ctx context.Context, id int64, tenantID int64) error
 	GetUpcomingTasksFunc      func(ctx context.Context, tenantID int64, limit int, offset int) ([]domain.Task, error)
+	FetchAllByAssigneeFunc    func(ctx context.Context, tenantID int64, assigneeID int64) ([]domain.Task, error)
+	GetUpcomingTasksByAssigneeFunc func(ctx context.Context, tenantID int64, assigneeID int64, limit int, offset int) ([]domain.Task, error)
 	GetCompletedTasksHistoryFunc func(ctx context.Context, tenantID int64, limit int) ([]domain.TaskWithUser, error)
+	GetCompletedTasksByUserFunc  func(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error)
+	FindNextOccurrenceFunc    func(ctx context.Context, taskID int64, tenantID int64) (*domain.Task, error)
+	GetPendingBySeriesFunc    func(ctx context.Context, seriesID int64, tenantID int64) ([]domain.Task, error)
+	GetSeriesHistoryFunc      func(ctx context.Context, seriesID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error)
+	MarkOverdueFunc           func(ctx context.Context, now time.Time) ([]domain.Task, error)
+	UnassignPendingFunc func(ctx context.Context, tenantID int64, assigneeID int64, from time.Time, to *time.Time, now time.Time) error
 }
 
 func (m *MockTaskRepository) Create(ctx context.Context, task *domain.Task) error {
@@ -37,6 +47,13 @@ func (m *MockTaskRepository) GetByID(ctx context.Context, id int64, tenantID int
 	return nil, nil
 }
 
+func (m *MockTaskRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+	if m.GetByIDForUpdateFunc != nil {
+		return m.GetByIDForUpdateFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
 func (m *MockTaskRepository) Update(ctx context.Context, task *domain.Task) error {
 	if m.UpdateFunc != nil {
 		return m.UpdateFunc(ctx, task)
@@ -58,6 +75,20 @@ func (m *MockTaskRepository) GetUpcomingTasks(ctx context.Context, tenantID int6
 	return []domain.Task{}, nil
 }
 
+func (m *MockTaskRepository) FetchAllByAssignee(ctx context.Context, tenantID int64, assigneeID int64) ([]domain.Task, error) {
+	if m.FetchAllByAssigneeFunc != nil {
+		return m.FetchAllByAssigneeFunc(ctx, tenantID, assigneeID)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) GetUpcomingTasksByAssignee(ctx context.Context, tenantID int64, assigneeID int64, limit int, offset int) ([]domain.Task, error) {
+	if m.GetUpcomingTasksByAssigneeFunc != nil {
+		return m.GetUpcomingTasksByAssigneeFunc(ctx, tenantID, assigneeID, limit, offset)
+	}
+	return []domain.Task{}, nil
+}
+
 func (m *MockTaskRepository) GetCompletedTasksHistory(ctx context.Context, tenantID int64, limit int) ([]domain.TaskWithUser, error) {
 	if m.GetCompletedTasksHistoryFunc != nil {
 		return m.GetCompletedTasksHistoryFunc(ctx, tenantID, limit)
@@ -65,16 +96,105 @@ func (m *MockTaskRepository) GetCompletedTasksHistory(ctx context.Context, tenan
 	return []domain.TaskWithUser{}, nil
 }
 
+func (m *MockTaskRepository) GetCompletedTasksByUser(ctx context.Context, userID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error) {
+	if m.GetCompletedTasksByUserFunc != nil {
+		return m.GetCompletedTasksByUserFunc(ctx, userID, tenantID, limit, offset)
+	}
+	return []domain.TaskWithUser{}, nil
+}
+
+func (m *MockTaskRepository) FindNextOccurrence(ctx context.Context, taskID int64, tenantID int64) (*domain.Task, error) {
+	if m.FindNextOccurrenceFunc != nil {
+		return m.FindNextOccurrenceFunc(ctx, taskID, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskRepository) GetPendingBySeries(ctx context.Context, seriesID int64, tenantID int64) ([]domain.Task, error) {
+	if m.GetPendingBySeriesFunc != nil {
+		return m.GetPendingBySeriesFunc(ctx, seriesID, tenantID)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) GetSeriesHistory(ctx context.Context, seriesID int64, tenantID int64, limit int, offset int) ([]domain.TaskWithUser, error) {
+	if m.GetSeriesHistoryFunc != nil {
+		return m.GetSeriesHistoryFunc(ctx, seriesID, tenantID, limit, offset)
+	}
+	return []domain.TaskWithUser{}, nil
+}
+
+func (m *MockTaskRepository) MarkOverdue(ctx context.Context, now time.Time) ([]domain.Task, error) {
+	if m.MarkOverdueFunc != nil {
+		return m.MarkOverdueFunc(ctx, now)
+	}
+	return []domain.Task{}, nil
+}
+
+func (m *MockTaskRepository) UnassignPending(ctx context.Context, tenantID int64, assigneeID int64, from time.Time, to *time.Time, now time.Time) error {
+	if m.UnassignPendingFunc != nil {
+		return m.UnassignPendingFunc(ctx, tenantID, assigneeID, from, to, now)
+	}
+	return nil
+}
+
+type MockTaskSeriesRepository struct {
+	CreateFunc   func(ctx context.Context, series *domain.TaskSeries) error
+	FetchAllFunc func(ctx context.Context, tenantID int64) ([]domain.TaskSeries, error)
+	GetByIDFunc  func(ctx context.Context, id int64, tenantID int64) (*domain.TaskSeries, error)
+	UpdateFunc   func(ctx context.Context, series *domain.TaskSeries) error
+	DeleteFunc   func(ctx context.Context, id int64, tenantID int64) error
+}
+
+func (m *MockTaskSeriesRepository) Create(ctx context.Context, series *domain.TaskSeries) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, series)
+	}
+	return nil
+}
+
+func (m *MockTaskSeriesRepository) FetchAll(ctx context.Context, tenantID int64) ([]domain.TaskSeries, error) {
+	if m.FetchAllFunc != nil {
+		return m.FetchAllFunc(ctx, tenantID)
+	}
+	return []domain.TaskSeries{}, nil
+}
+
+func (m *MockTaskSeriesRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.TaskSeries, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskSeriesRepository) Update(ctx context.Context, series *domain.TaskSeries) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, series)
+	}
+	return nil
+}
+
+func (m *MockTaskSeriesRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, tenantID)
+	}
+	return nil
+}
+
 type MockUserRepository struct {
-	GetByIDFunc func(ctx context.Context, id int64) (*domain.User, error)
+	GetByIDFunc func(ctx context.Context, id int64, tenantID int64) (*domain.User, error)
 	UpdateFunc  func(ctx context.Context, user *domain.User) error
 }
 
-func (m *MockUserRepository) GetByID(ctx context.Context, id int64) (*domain.User, error) {
+func (m *MockUserRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
 	if m.GetByIDFunc != nil {
-		return m.GetByIDFunc(ctx, id)
+		return m.GetByIDFunc(ctx, id, tenantID)
 	}
-	return &domain.User{ID: id, Points: 0}, nil
+	return &domain.User{ID: id, TenantID: tenantID, Points: 0}, nil
+}
+
+func (m *MockUserRepository) GetByIDForUpdate(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	return m.GetByID(ctx, id, tenantID)
 }
 
 func (m *MockUserRepository) Update(ctx context.Context, user *domain.User) error {
@@ -104,15 +224,47 @@ func (m *MockUserRepository) GetTopUsersByPoints(ctx context.Context, tenantID i
 	return nil, nil
 }
 
-func (m *MockUserRepository) Delete(ctx context.Context, id int64) error {
+func (m *MockUserRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
 	return nil
 }
 
+type MockTaskRotationRepository struct {
+	CreateFunc             func(ctx context.Context, rotation *domain.TaskRotation) error
+	GetByIDFunc            func(ctx context.Context, id int64, tenantID int64) (*domain.TaskRotation, error)
+	UpdateFunc             func(ctx context.Context, rotation *domain.TaskRotation) error
+	GetLastCompletionsFunc func(ctx context.Context, rotationID int64, tenantID int64) (map[int64]time.Time, error)
+}
+
+func (m *MockTaskRotationRepository) Create(ctx context.Context, rotation *domain.TaskRotation) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, rotation)
+	}
+	return nil
+}
+
+func (m *MockTaskRotationRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.TaskRotation, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockTaskRotationRepository) Update(ctx context.Context, rotation *domain.TaskRotation) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, rotation)
+	}
+	return nil
+}
+
+func (m *MockTaskRotationRepository) GetLastCompletions(ctx context.Context, rotationID int64, tenantID int64) (map[int64]time.Time, error) {
+	if m.GetLastCompletionsFunc != nil {
+		return m.GetLastCompletionsFunc(ctx, rotationID, tenantID)
+	}
+	return map[int64]time.Time{}, nil
+}
+
 type MockDispatcher struct {
-	DispatchFunc       func(event events.Event) error
-	RegisterHandlerFunc func(eventType events.EventType, handler events.EventHandler)
-	StartFunc          func()
-	StopFunc           func()
+	DispatchFunc func(event events.Event) error
 }
 
 func (m *MockDispatcher) Dispatch(event events.Event) error {
@@ -122,20 +274,166 @@ func (m *MockDispatcher) Dispatch(event events.Event) error {
 	return nil
 }
 
-func (m *MockDispatcher) RegisterHandler(eventType events.EventType, handler events.EventHandler) {
-	if m.RegisterHandlerFunc != nil {
-		m.RegisterHandlerFunc(eventType, handler)
+func (m *MockDispatcher) Publish(ctx context.Context, event events.Event) error {
+	return m.Dispatch(event)
+}
+
+type MockTransactor struct {
+	WithinTransactionFunc func(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+func (m *MockTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.WithinTransactionFunc != nil {
+		return m.WithinTransactionFunc(ctx, fn)
+	}
+	return fn(ctx)
+}
+
+type MockStreakRepository struct {
+	GetFunc func(ctx context.Context, kind domain.StreakKind, subjectID int64, tenantID int64) (*domain.Streak, error)
+}
+
+func (m *MockStreakRepository) MarkEventProcessed(ctx context.Context, eventID int64) (bool, error) {
+	return true, nil
+}
+
+func (m *MockStreakRepository) Get(ctx context.Context, kind domain.StreakKind, subjectID int64, tenantID int64) (*domain.Streak, error) {
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, kind, subjectID, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockStreakRepository) Save(ctx context.Context, streak *domain.Streak) error {
+	return nil
+}
+
+func (m *MockStreakRepository) AddActivity(ctx context.Context, tenantID int64, userID int64, day time.Time, delta int) (int, error) {
+	return delta, nil
+}
+
+func (m *MockStreakRepository) FetchActivityDays(ctx context.Context, userID int64, tenantID int64) ([]time.Time, error) {
+	return []time.Time{}, nil
+}
+
+func (m *MockStreakRepository) RecordOccurrence(ctx context.Context, tenantID int64, seriesID int64, occurrence domain.StreakOccurrence) error {
+	return nil
+}
+
+func (m *MockStreakRepository) RemoveOccurrence(ctx context.Context, taskID int64, tenantID int64) error {
+	return nil
+}
+
+func (m *MockStreakRepository) FetchOccurrences(ctx context.Context, seriesID int64, tenantID int64) ([]domain.StreakOccurrence, error) {
+	return []domain.StreakOccurrence{}, nil
+}
+
+type MockAbsenceRepository struct {
+	CreateFunc           func(ctx context.Context, absence *domain.Absence) error
+	GetByIDFunc          func(ctx context.Context, id int64, tenantID int64) (*domain.Absence, error)
+	FetchByUserFunc      func(ctx context.Context, userID int64, tenantID int64) ([]domain.Absence, error)
+	FetchOverlappingFunc func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error)
+	UpdateFunc           func(ctx context.Context, absence *domain.Absence) error
+	DeleteFunc           func(ctx context.Context, id int64, tenantID int64) error
+}
+
+func (m *MockAbsenceRepository) Create(ctx context.Context, absence *domain.Absence) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, absence)
+	}
+	return nil
+}
+
+func (m *MockAbsenceRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.Absence, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
 	}
+	return nil, nil
 }
 
-func (m *MockDispatcher) Start() {
-	if m.StartFunc != nil {
-		m.StartFunc()
+func (m *MockAbsenceRepository) FetchByUser(ctx context.Context, userID int64, tenantID int64) ([]domain.Absence, error) {
+	if m.FetchByUserFunc != nil {
+		return m.FetchByUserFunc(ctx, userID, tenantID)
 	}
+	return []domain.Absence{}, nil
+}
+
+func (m *MockAbsenceRepository) FetchOverlapping(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error) {
+	if m.FetchOverlappingFunc != nil {
+		return m.FetchOverlappingFunc(ctx, tenantID, from, to)
+	}
+	return []domain.Absence{}, nil
+}
+
+func (m *MockAbsenceRepository) Update(ctx context.Context, absence *domain.Absence) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, absence)
+	}
+	return nil
+}
+
+func (m *MockAbsenceRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, tenantID)
+	}
+	return nil
+}
+
+type MockChecklistRepository struct {
+	CreateFunc      func(ctx context.Context, item *domain.ChecklistItem) error
+	GetByIDFunc     func(ctx context.Context, id int64, tenantID int64) (*domain.ChecklistItem, error)
+	FetchByTaskFunc func(ctx context.Context, taskID int64, tenantID int64) ([]domain.ChecklistItem, error)
+	UpdateFunc      func(ctx context.Context, item *domain.ChecklistItem) error
+	DeleteFunc      func(ctx context.Context, id int64, tenantID int64) error
+	RecordAuditFunc func(ctx context.Context, entry *domain.ChecklistAuditEntry) error
+	FetchAuditFunc  func(ctx context.Context, taskID int64, tenantID int64, limit int, offset int) ([]domain.ChecklistAuditEntry, error)
+}
+
+func (m *MockChecklistRepository) Create(ctx context.Context, item *domain.ChecklistItem) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return nil
+}
+
+func (m *MockChecklistRepository) GetByID(ctx context.Context, id int64, tenantID int64) (*domain.ChecklistItem, error) {
+	if m.GetByIDFunc != nil {
+		return m.GetByIDFunc(ctx, id, tenantID)
+	}
+	return nil, nil
+}
+
+func (m *MockChecklistRepository) FetchByTask(ctx context.Context, taskID int64, tenantID int64) ([]domain.ChecklistItem, error) {
+	if m.FetchByTaskFunc != nil {
+		return m.FetchByTaskFunc(ctx, taskID, tenantID)
+	}
+	return []domain.ChecklistItem{}, nil
+}
+
+func (m *MockChecklistRepository) Update(ctx context.Context, item *domain.ChecklistItem) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, item)
+	}
+	return nil
+}
+
+func (m *MockChecklistRepository) Delete(ctx context.Context, id int64, tenantID int64) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id, tenantID)
+	}
+	return nil
+}
+
+func (m *MockChecklistRepository) RecordAudit(ctx context.Context, entry *domain.ChecklistAuditEntry) error {
+	if m.RecordAuditFunc != nil {
+		return m.RecordAuditFunc(ctx, entry)
+	}
+	return nil
 }
 
-func (m *MockDispatcher) Stop() {
-	if m.StopFunc != nil {
-		m.StopFunc()
+func (m *MockChecklistRepository) FetchAudit(ctx context.Context, taskID int64, tenantID int64, limit int, offset int) ([]domain.ChecklistAuditEntry, error) {
+	if m.FetchAuditFunc != nil {
+		return m.FetchAuditFunc(ctx, taskID, tenantID, limit, offset)
 	}
+	return []domain.ChecklistAuditEntry{}, nil
 }
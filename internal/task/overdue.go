@@ -0,0 +1,57 @@
+package task
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
+	"time"
+)
+
+type OverdueJob struct {
+	repo       domain.TaskRepository
+	dispatcher events.Publisher
+	transactor domain.Transactor
+}
+
+func NewOverdueJob(repo domain.TaskRepository, dispatcher events.Publisher, transactor domain.Transactor) *OverdueJob {
+	return &OverdueJob{
+		repo:       repo,
+		dispatcher: dispatcher,
+		transactor: transactor,
+	}
+}
+
+func (j *OverdueJob) Run(ctx context.Context) error {
+	now := time.Now()
+
+	return j.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		tasks, err := j.repo.MarkOverdue(ctx, now)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			if task.ScheduledTo == nil {
+				continue
+			}
+
+			event := events.Event{
+				Type: events.EventTypeTaskOverdue,
+				Payload: events.TaskOverduePayload{
+					TaskID:      task.ID,
+					TaskTitle:   task.Title,
+					TenantID:    task.TenantID,
+					AssigneeID:  task.AssigneeID,
+					ScheduledTo: *task.ScheduledTo,
+				},
+				Timestamp: now,
+			}
+
+			if err := j.dispatcher.Publish(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,262 @@
+package task
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+func (s *Service) GetTaskRotation(ctx context.Context, id int64) (*domain.TaskRotation, error) {
+	task, err := s.GetTaskByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.RotationID == nil {
+		return nil, ErrRotationNotFound
+	}
+
+	rotation, err := s.rotationRepo.GetByID(ctx, *task.RotationID, task.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rotation == nil {
+		return nil, ErrRotationNotFound
+	}
+
+	return rotation, nil
+}
+
+func (s *Service) createRotation(ctx context.Context, req RotationRequest, tenantID int64, assigneeID *int64, at time.Time) (*domain.TaskRotation, *int64, error) {
+	memberIDs, err := s.validateRotation(ctx, req, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	rotation := &domain.TaskRotation{
+		TenantID:  tenantID,
+		Strategy:  req.Strategy,
+		MemberIDs: memberIDs,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if assigneeID == nil {
+		assigneeID, err = s.nextRotationAssignee(ctx, rotation, at)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if assigneeID != nil && rotation.HasMember(*assigneeID) {
+		rotation.LastAssigneeID = assigneeID
+	}
+
+	if err := s.rotationRepo.Create(ctx, rotation); err != nil {
+		return nil, nil, err
+	}
+
+	return rotation, assigneeID, nil
+}
+
+func (s *Service) updateRotation(ctx context.Context, task *domain.Task, req RotationRequest) error {
+	if len(req.MemberIDs) == 0 {
+		task.RotationID = nil
+		return nil
+	}
+
+	if task.RotationID == nil {
+		at := time.Now()
+		if task.ScheduledTo != nil {
+			at = *task.ScheduledTo
+		}
+		rotation, assigneeID, err := s.createRotation(ctx, req, task.TenantID, task.AssigneeID, at)
+		if err != nil {
+			return err
+		}
+		task.RotationID = &rotation.ID
+		task.AssigneeID = assigneeID
+		return nil
+	}
+
+	rotation, err := s.rotationRepo.GetByID(ctx, *task.RotationID, task.TenantID)
+	if err != nil {
+		return err
+	}
+
+	if rotation == nil {
+		return ErrRotationNotFound
+	}
+
+	memberIDs, err := s.validateRotation(ctx, req, task.TenantID)
+	if err != nil {
+		return err
+	}
+
+	rotation.Strategy = req.Strategy
+	rotation.MemberIDs = memberIDs
+	if rotation.LastAssigneeID != nil && !rotation.HasMember(*rotation.LastAssigneeID) {
+		rotation.LastAssigneeID = nil
+	}
+	rotation.UpdatedAt = time.Now()
+
+	return s.rotationRepo.Update(ctx, rotation)
+}
+
+func (s *Service) validateRotation(ctx context.Context, req RotationRequest, tenantID int64) ([]int64, error) {
+	if !req.Strategy.IsValid() || len(req.MemberIDs) == 0 {
+		return nil, ErrInvalidRotation
+	}
+
+	seen := make(map[int64]bool, len(req.MemberIDs))
+	memberIDs := make([]int64, 0, len(req.MemberIDs))
+	for _, memberID := range req.MemberIDs {
+		if seen[memberID] {
+			continue
+		}
+		if err := s.validateAssignee(ctx, memberID, tenantID, nil); err != nil {
+			return nil, err
+		}
+		seen[memberID] = true
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	return memberIDs, nil
+}
+
+func (s *Service) advanceRotation(ctx context.Context, task *domain.Task, at time.Time) (*int64, error) {
+	rotation, err := s.rotationRepo.GetByID(ctx, *task.RotationID, task.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rotation == nil {
+		return task.AssigneeID, nil
+	}
+
+	nextAssigneeID, err := s.nextRotationAssignee(ctx, rotation, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if nextAssigneeID == nil {
+		return s.availableAssignee(ctx, task.AssigneeID, task.TenantID, at)
+	}
+
+	rotation.LastAssigneeID = nextAssigneeID
+	rotation.UpdatedAt = time.Now()
+	if err := s.rotationRepo.Update(ctx, rotation); err != nil {
+		return nil, err
+	}
+
+	return nextAssigneeID, nil
+}
+
+func (s *Service) rewindRotation(ctx context.Context, task *domain.Task) error {
+	rotation, err := s.rotationRepo.GetByID(ctx, *task.RotationID, task.TenantID)
+	if err != nil {
+		return err
+	}
+
+	if rotation == nil {
+		return nil
+	}
+
+	rotation.LastAssigneeID = task.AssigneeID
+	rotation.UpdatedAt = time.Now()
+
+	return s.rotationRepo.Update(ctx, rotation)
+}
+
+func (s *Service) nextRotationAssignee(ctx context.Context, rotation *domain.TaskRotation, at time.Time) (*int64, error) {
+	candidates, err := s.rotationCandidates(ctx, rotation, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	next := candidates[0]
+
+	switch rotation.Strategy {
+	case domain.RotationFewestPoints:
+		for _, candidate := range candidates[1:] {
+			if candidate.Points < next.Points {
+				next = candidate
+			}
+		}
+	case domain.RotationLeastRecentlyCompleted:
+		if rotation.ID == 0 {
+			break
+		}
+		completions, err := s.rotationRepo.GetLastCompletions(ctx, rotation.ID, rotation.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		for _, candidate := range candidates[1:] {
+			if completions[candidate.ID].Before(completions[next.ID]) {
+				next = candidate
+			}
+		}
+	}
+
+	return &next.ID, nil
+}
+
+func (s *Service) rotationCandidates(ctx context.Context, rotation *domain.TaskRotation, at time.Time) ([]domain.User, error) {
+	absences, err := s.fetchAbsences(ctx, rotation.TenantID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	start := 0
+	if rotation.LastAssigneeID != nil {
+		for i, memberID := range rotation.MemberIDs {
+			if memberID == *rotation.LastAssigneeID {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	candidates := make([]domain.User, 0, len(rotation.MemberIDs))
+	for i := range rotation.MemberIDs {
+		memberID := rotation.MemberIDs[(start+i)%len(rotation.MemberIDs)]
+		member, err := s.userRepo.GetByID(ctx, memberID, rotation.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		if member == nil || member.Status != "active" || domain.IsAbsent(absences, memberID, at) {
+			continue
+		}
+		candidates = append(candidates, *member)
+	}
+
+	return candidates, nil
+}
+
+func (s *Service) availableAssignee(ctx context.Context, assigneeID *int64, tenantID int64, at time.Time) (*int64, error) {
+	if assigneeID == nil {
+		return nil, nil
+	}
+
+	absences, err := s.fetchAbsences(ctx, tenantID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if domain.IsAbsent(absences, *assigneeID, at) {
+		return nil, nil
+	}
+
+	return assigneeID, nil
+}
+
+func (s *Service) fetchAbsences(ctx context.Context, tenantID int64, at time.Time) ([]domain.Absence, error) {
+	day := domain.CalendarDay(at)
+	return s.absenceRepo.FetchOverlapping(ctx, tenantID, day, day.AddDate(0, 0, 1))
+}
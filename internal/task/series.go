@@ -0,0 +1,359 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"time"
+)
+
+func (s *Service) ListSeries(ctx context.Context) ([]domain.TaskSeries, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	return s.seriesRepo.FetchAll(ctx, tenantID)
+}
+
+func (s *Service) GetSeries(ctx context.Context, id int64) (*domain.TaskSeries, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	series, err := s.seriesRepo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if series == nil {
+		return nil, ErrSeriesNotFound
+	}
+
+	return series, nil
+}
+
+func (s *Service) UpdateSeries(ctx context.Context, id int64, req UpdateSeriesRequest) (*domain.TaskSeries, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.TaskSeries, error) {
+		return s.updateSeries(ctx, id, req)
+	})
+}
+
+func (s *Service) updateSeries(ctx context.Context, id int64, req UpdateSeriesRequest) (*domain.TaskSeries, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTasks); err != nil {
+		return nil, err
+	}
+
+	series, err := s.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil {
+		series.Title = *req.Title
+	}
+	if req.Description != nil {
+		series.Description = *req.Description
+	}
+	if req.Points != nil {
+		series.Points = *req.Points
+	}
+	if req.FrequencyValue != nil {
+		series.FrequencyValue = *req.FrequencyValue
+	}
+	if req.FrequencyUnit != nil {
+		series.FrequencyUnit = *req.FrequencyUnit
+	}
+	if req.RecurrenceRule != nil {
+		recurrenceRule, err := normalizeRecurrenceRule(req.RecurrenceRule)
+		if err != nil {
+			return nil, err
+		}
+		series.RecurrenceRule = recurrenceRule
+	}
+	if req.AnchorMode != nil {
+		if !req.AnchorMode.IsValid() {
+			return nil, ErrInvalidAnchorMode
+		}
+		series.AnchorMode = *req.AnchorMode
+	}
+
+	if series.RecurrenceRule == nil && (series.FrequencyValue <= 0 || series.FrequencyUnit == "") {
+		return nil, ErrFrequencyNotDefined
+	}
+
+	now := time.Now()
+	series.UpdatedAt = now
+	series.UpdatedById = &userID
+
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.repo.GetPendingBySeries(ctx, series.ID, series.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pending {
+		series.ApplyTo(&pending[i])
+		pending[i].UpdatedAt = now
+		pending[i].UpdatedById = &userID
+		if err := s.repo.Update(ctx, &pending[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return series, nil
+}
+
+func (s *Service) PauseSeries(ctx context.Context, id int64) (*domain.TaskSeries, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.TaskSeries, error) {
+		return s.pauseSeries(ctx, id)
+	})
+}
+
+func (s *Service) pauseSeries(ctx context.Context, id int64) (*domain.TaskSeries, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTasks); err != nil {
+		return nil, err
+	}
+
+	series, err := s.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if series.IsPaused() {
+		return series, nil
+	}
+
+	now := time.Now()
+	series.PausedAt = &now
+	series.UpdatedAt = now
+	series.UpdatedById = &userID
+
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (s *Service) ResumeSeries(ctx context.Context, id int64) (*domain.TaskSeries, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.TaskSeries, error) {
+		return s.resumeSeries(ctx, id)
+	})
+}
+
+func (s *Service) resumeSeries(ctx context.Context, id int64) (*domain.TaskSeries, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+	if userID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTasks); err != nil {
+		return nil, err
+	}
+
+	series, err := s.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !series.IsPaused() {
+		return series, nil
+	}
+
+	now := time.Now()
+	series.PausedAt = nil
+	series.UpdatedAt = now
+	series.UpdatedById = &userID
+
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.repo.GetPendingBySeries(ctx, series.ID, series.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pending) > 0 {
+		return series, nil
+	}
+
+	history, err := s.repo.GetSeriesHistory(ctx, series.ID, series.TenantID, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := &domain.Task{TenantID: series.TenantID, Status: "pending"}
+	if len(history) > 0 {
+		previous = &history[0].Task
+	}
+
+	scheduledTo := now
+	if series.RecurrenceRule != nil {
+		next, ok, err := series.NextDueDate(nil, now)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return series, nil
+		}
+		scheduledTo = next
+	}
+
+	if _, err := s.spawnNextOccurrence(ctx, previous, series, scheduledTo); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (s *Service) DeleteSeries(ctx context.Context, id int64) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		return s.deleteSeries(ctx, id)
+	})
+}
+
+func (s *Service) deleteSeries(ctx context.Context, id int64) error {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTasks); err != nil {
+		return err
+	}
+
+	pending, err := s.repo.GetPendingBySeries(ctx, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range pending {
+		if err := s.repo.Delete(ctx, task.ID, tenantID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.seriesRepo.Delete(ctx, id, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSeriesNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetSeriesHistory(ctx context.Context, id int64, limit int, offset int) ([]domain.TaskWithUser, error) {
+	series, err := s.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetSeriesHistory(ctx, series.ID, series.TenantID, limit, offset)
+}
+
+func (s *Service) ensureSeries(ctx context.Context, task *domain.Task) (*domain.TaskSeries, error) {
+	if task.SeriesID != nil {
+		return s.seriesRepo.GetByID(ctx, *task.SeriesID, task.TenantID)
+	}
+
+	now := time.Now()
+	startsAt := task.CreatedAt
+	if task.ScheduledTo != nil {
+		startsAt = *task.ScheduledTo
+	}
+
+	series := &domain.TaskSeries{
+		TenantID:       task.TenantID,
+		Title:          task.Title,
+		Description:    task.Description,
+		Points:         task.Points,
+		FrequencyValue: task.FrequencyValue,
+		FrequencyUnit:  task.FrequencyUnit,
+		RecurrenceRule: task.RecurrenceRule,
+		StartsAt:       startsAt,
+		AnchorMode:     task.AnchorMode,
+		CreatedAt:      now,
+		CreatedById:    task.CreatedById,
+		UpdatedAt:      now,
+	}
+
+	if err := s.seriesRepo.Create(ctx, series); err != nil {
+		return nil, err
+	}
+
+	task.SeriesID = &series.ID
+	return series, nil
+}
+
+func (s *Service) spawnNextOccurrence(ctx context.Context, previous *domain.Task, series *domain.TaskSeries, scheduledTo time.Time) (*domain.Task, error) {
+	userID := middleware.GetUserIDFromContext(ctx)
+
+	var nextAssigneeID *int64
+	var err error
+	if previous.RotationID != nil {
+		nextAssigneeID, err = s.advanceRotation(ctx, previous, scheduledTo)
+	} else {
+		nextAssigneeID, err = s.availableAssignee(ctx, previous.AssigneeID, series.TenantID, scheduledTo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var previousTaskID *int64
+	if previous.ID != 0 {
+		previousTaskID = &previous.ID
+	}
+
+	now := time.Now()
+	newTask := &domain.Task{
+		Status:         previous.Status,
+		ScheduledTo:    &scheduledTo,
+		ScheduledById:  previous.ScheduledById,
+		Completed:      false,
+		CompletedById:  nil,
+		AssigneeID:     nextAssigneeID,
+		RotationID:     previous.RotationID,
+		SeriesID:       &series.ID,
+		PreviousTaskID: previousTaskID,
+		TenantID:       series.TenantID,
+		CreatedAt:      now,
+		CreatedById:    userID,
+		UpdatedAt:      now,
+	}
+	series.ApplyTo(newTask)
+
+	if err := s.repo.Create(ctx, newTask); err != nil {
+		return nil, err
+	}
+
+	if err := s.copyChecklist(ctx, previous, newTask); err != nil {
+		return nil, err
+	}
+
+	if previous.RotationID != nil {
+		if err := s.publishAssigned(ctx, newTask, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return newTask, nil
+}
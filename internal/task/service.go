@@ -9,25 +9,106 @@ import (
 )
 
 type Service struct {
-	repo      domain.TaskRepository
-	dispatcher events.EventDispatcher
+	repo          domain.TaskRepository
+	userRepo      domain.UserRepository
+	rotationRepo  domain.TaskRotationRepository
+	seriesRepo    domain.TaskSeriesRepository
+	streakRepo    domain.StreakRepository
+	absenceRepo   domain.AbsenceRepository
+	checklistRepo domain.ChecklistRepository
+	dispatcher    events.Publisher
+	transactor    domain.Transactor
 }
 
-func NewService(repo domain.TaskRepository, dispatcher events.EventDispatcher) *Service {
+func NewService(repo domain.TaskRepository, userRepo domain.UserRepository, rotationRepo domain.TaskRotationRepository, seriesRepo domain.TaskSeriesRepository, streakRepo domain.StreakRepository, absenceRepo domain.AbsenceRepository, checklistRepo domain.ChecklistRepository, dispatcher events.Publisher, transactor domain.Transactor) *Service {
 	return &Service{
-		repo:      repo,
-		dispatcher: dispatcher,
+		repo:          repo,
+		userRepo:      userRepo,
+		rotationRepo:  rotationRepo,
+		seriesRepo:    seriesRepo,
+		streakRepo:    streakRepo,
+		absenceRepo:   absenceRepo,
+		checklistRepo: checklistRepo,
+		dispatcher:    dispatcher,
+		transactor:    transactor,
 	}
 }
 
 func (s *Service) CreateTask(ctx context.Context, req CreateTaskRequest) (*domain.Task, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.Task, error) {
+		return s.createTask(ctx, req)
+	})
+}
+
+func (s *Service) createTask(ctx context.Context, req CreateTaskRequest) (*domain.Task, error) {
 	userID := middleware.GetUserIDFromContext(ctx)
 	tenantID := middleware.GetTenantIDFromContext(ctx)
 	if userID == 0 || tenantID == 0 {
 		return nil, ErrUserNotAuthenticated
 	}
 
+	assigneeID := req.AssigneeID
+	if assigneeID != nil {
+		if err := s.validateAssignee(ctx, *assigneeID, tenantID, req.ScheduledTo); err != nil {
+			return nil, err
+		}
+	}
+
+	var rotationID *int64
+	if req.Rotation != nil {
+		at := time.Now()
+		if req.ScheduledTo != nil {
+			at = *req.ScheduledTo
+		}
+		rotation, rotationAssigneeID, err := s.createRotation(ctx, *req.Rotation, tenantID, assigneeID, at)
+		if err != nil {
+			return nil, err
+		}
+		rotationID = &rotation.ID
+		assigneeID = rotationAssigneeID
+	}
+
+	recurrenceRule, err := normalizeRecurrenceRule(req.RecurrenceRule)
+	if err != nil {
+		return nil, err
+	}
+
+	anchorMode := req.AnchorMode
+	if anchorMode == "" {
+		anchorMode = domain.AnchorCompletion
+	}
+	if !anchorMode.IsValid() {
+		return nil, ErrInvalidAnchorMode
+	}
+
 	now := time.Now()
+	var seriesID *int64
+	if recurrenceRule != nil || (req.FrequencyValue > 0 && req.FrequencyUnit != "") {
+		startsAt := now
+		if req.ScheduledTo != nil {
+			startsAt = *req.ScheduledTo
+		}
+
+		series := &domain.TaskSeries{
+			TenantID:       tenantID,
+			Title:          req.Title,
+			Description:    req.Description,
+			Points:         req.Points,
+			FrequencyValue: req.FrequencyValue,
+			FrequencyUnit:  req.FrequencyUnit,
+			RecurrenceRule: recurrenceRule,
+			StartsAt:       startsAt,
+			AnchorMode:     anchorMode,
+			CreatedAt:      now,
+			CreatedById:    userID,
+			UpdatedAt:      now,
+		}
+		if err := s.seriesRepo.Create(ctx, series); err != nil {
+			return nil, err
+		}
+		seriesID = &series.ID
+	}
+
 	task := &domain.Task{
 		Title:          req.Title,
 		Description:    req.Description,
@@ -38,6 +119,11 @@ func (s *Service) CreateTask(ctx context.Context, req CreateTaskRequest) (*domai
 		FrequencyValue: req.FrequencyValue,
 		FrequencyUnit:  req.FrequencyUnit,
 		Completed:      false,
+		AssigneeID:     assigneeID,
+		RotationID:     rotationID,
+		SeriesID:       seriesID,
+		RecurrenceRule: recurrenceRule,
+		AnchorMode:     anchorMode,
 		TenantID:       tenantID,
 		CreatedAt:      now,
 		CreatedById:    userID,
@@ -48,6 +134,10 @@ func (s *Service) CreateTask(ctx context.Context, req CreateTaskRequest) (*domai
 		return nil, err
 	}
 
+	if err := s.publishAssigned(ctx, task, userID); err != nil {
+		return nil, err
+	}
+
 	return task, nil
 }
 
@@ -66,15 +156,25 @@ func (s *Service) GetTaskByID(ctx context.Context, id int64) (*domain.Task, erro
 		return nil, ErrTaskNotFound
 	}
 
+	checklist, err := s.checklistRepo.FetchByTask(ctx, task.ID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	task.Checklist = checklist
+
 	return task, nil
 }
 
-func (s *Service) ListTasks(ctx context.Context) ([]domain.Task, error) {
+func (s *Service) ListTasks(ctx context.Context, filter TaskFilter) ([]domain.Task, error) {
 	tenantID := middleware.GetTenantIDFromContext(ctx)
 	if tenantID == 0 {
 		return nil, ErrUserNotAuthenticated
 	}
 
+	if filter.AssigneeID != nil {
+		return s.repo.FetchAllByAssignee(ctx, tenantID, *filter.AssigneeID)
+	}
+
 	tasks, err := s.repo.FetchAll(ctx, tenantID)
 	if err != nil {
 		return nil, err
@@ -84,12 +184,28 @@ func (s *Service) ListTasks(ctx context.Context) ([]domain.Task, error) {
 }
 
 func (s *Service) UpdateTask(ctx context.Context, id int64, req UpdateTaskRequest) (*domain.Task, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.Task, error) {
+		return s.updateTask(ctx, id, req)
+	})
+}
+
+func (s *Service) updateTask(ctx context.Context, id int64, req UpdateTaskRequest) (*domain.Task, error) {
 	userID := middleware.GetUserIDFromContext(ctx)
 	tenantID := middleware.GetTenantIDFromContext(ctx)
 	if userID == 0 || tenantID == 0 {
 		return nil, ErrUserNotAuthenticated
 	}
 
+	if req.Completed != nil || req.CompletedAt != nil {
+		return nil, ErrCompletionNotEditable
+	}
+
+	if req.Points != nil || req.AssigneeID != nil || req.Rotation != nil {
+		if err := middleware.Authorize(ctx, middleware.PermissionManageTasks); err != nil {
+			return nil, err
+		}
+	}
+
 	task, err := s.repo.GetByID(ctx, id, tenantID)
 	if err != nil {
 		return nil, err
@@ -99,6 +215,8 @@ func (s *Service) UpdateTask(ctx context.Context, id int64, req UpdateTaskReques
 		return nil, ErrTaskNotFound
 	}
 
+	previousAssigneeID := task.AssigneeID
+
 	if req.Title != nil {
 		task.Title = *req.Title
 	}
@@ -113,27 +231,60 @@ func (s *Service) UpdateTask(ctx context.Context, id int64, req UpdateTaskReques
 	}
 	if req.ScheduledTo != nil {
 		task.ScheduledTo = req.ScheduledTo
+		task.OverdueAt = nil
 	}
 	if req.ScheduledById != nil {
 		task.ScheduledById = req.ScheduledById
 	}
-	if req.FrequencyValue != nil {
-		task.FrequencyValue = *req.FrequencyValue
-	}
-	if req.FrequencyUnit != nil {
-		task.FrequencyUnit = *req.FrequencyUnit
-	}
-	if req.Completed != nil {
-		task.Completed = *req.Completed
-		if *req.Completed && req.CompletedAt != nil {
-			task.CompletedById = &userID
-			nextDueDate, err := task.CalculateNextDueDate(*req.CompletedAt)
+	changesRecurrence := req.FrequencyValue != nil || req.FrequencyUnit != nil || req.RecurrenceRule != nil || req.AnchorMode != nil
+	if task.SeriesID != nil && changesRecurrence {
+		series, err := s.updateSeries(ctx, *task.SeriesID, UpdateSeriesRequest{
+			FrequencyValue: req.FrequencyValue,
+			FrequencyUnit:  req.FrequencyUnit,
+			RecurrenceRule: req.RecurrenceRule,
+			AnchorMode:     req.AnchorMode,
+		})
+		if err != nil {
+			return nil, err
+		}
+		task.FrequencyValue = series.FrequencyValue
+		task.FrequencyUnit = series.FrequencyUnit
+		task.RecurrenceRule = series.RecurrenceRule
+		task.AnchorMode = series.AnchorMode
+	} else {
+		if req.FrequencyValue != nil {
+			task.FrequencyValue = *req.FrequencyValue
+		}
+		if req.FrequencyUnit != nil {
+			task.FrequencyUnit = *req.FrequencyUnit
+		}
+		if req.RecurrenceRule != nil {
+			recurrenceRule, err := normalizeRecurrenceRule(req.RecurrenceRule)
 			if err != nil {
 				return nil, err
 			}
-			task.ScheduledTo = &nextDueDate
-		} else if !*req.Completed {
-			task.CompletedById = nil
+			task.RecurrenceRule = recurrenceRule
+		}
+		if req.AnchorMode != nil {
+			if !req.AnchorMode.IsValid() {
+				return nil, ErrInvalidAnchorMode
+			}
+			task.AnchorMode = *req.AnchorMode
+		}
+	}
+	if req.AssigneeID != nil {
+		if *req.AssigneeID == 0 {
+			task.AssigneeID = nil
+		} else {
+			if err := s.validateAssignee(ctx, *req.AssigneeID, tenantID, task.ScheduledTo); err != nil {
+				return nil, err
+			}
+			task.AssigneeID = req.AssigneeID
+		}
+	}
+	if req.Rotation != nil {
+		if err := s.updateRotation(ctx, task, *req.Rotation); err != nil {
+			return nil, err
 		}
 	}
 
@@ -144,17 +295,29 @@ func (s *Service) UpdateTask(ctx context.Context, id int64, req UpdateTaskReques
 		return nil, err
 	}
 
+	if task.AssigneeID != nil && (previousAssigneeID == nil || *previousAssigneeID != *task.AssigneeID) {
+		if err := s.publishAssigned(ctx, task, userID); err != nil {
+			return nil, err
+		}
+	}
+
 	return task, nil
 }
 
 func (s *Service) CompleteTask(ctx context.Context, id int64, req CompleteTaskRequest) (*domain.Task, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.Task, error) {
+		return s.completeTask(ctx, id, req)
+	})
+}
+
+func (s *Service) completeTask(ctx context.Context, id int64, req CompleteTaskRequest) (*domain.Task, error) {
 	userID := middleware.GetUserIDFromContext(ctx)
 	tenantID := middleware.GetTenantIDFromContext(ctx)
 	if userID == 0 || tenantID == 0 {
 		return nil, ErrUserNotAuthenticated
 	}
 
-	task, err := s.repo.GetByID(ctx, id, tenantID)
+	task, err := s.repo.GetByIDForUpdate(ctx, id, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -172,9 +335,28 @@ func (s *Service) CompleteTask(ctx context.Context, id int64, req CompleteTaskRe
 		completedByID = *req.CompletedById
 	}
 
+	if completedByID != userID {
+		if err := middleware.Authorize(ctx, middleware.PermissionCompleteTaskForOthers); err != nil {
+			return nil, err
+		}
+	}
+
+	if task.AssigneeID != nil && !task.IsAssignedTo(completedByID) && !middleware.IsAdmin(ctx) {
+		return nil, ErrNotTaskAssignee
+	}
+
+	var series *domain.TaskSeries
+	if task.IsRecurring() {
+		series, err = s.ensureSeries(ctx, task)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	now := time.Now()
 	task.Completed = true
 	task.CompletedById = &completedByID
+	task.CompletedAt = &now
 	task.UpdatedAt = now
 	task.UpdatedById = &userID
 
@@ -182,45 +364,35 @@ func (s *Service) CompleteTask(ctx context.Context, id int64, req CompleteTaskRe
 		return nil, err
 	}
 
-	if task.Points > 0 {
-		event := events.Event{
-			Type:      events.EventTypeTaskCompleted,
-			Payload:   events.TaskCompletedPayload{
-				CompletedBy: completedByID,
-				Points:      task.Points,
-			},
-			Timestamp: now,
-		}
-		if err := s.dispatcher.Dispatch(event); err != nil {
-			return nil, err
-		}
+	event := events.Event{
+		Type: events.EventTypeTaskCompleted,
+		Payload: events.TaskCompletedPayload{
+			TaskID:      task.ID,
+			TaskTitle:   task.Title,
+			CompletedBy: completedByID,
+			TenantID:    tenantID,
+			Points:      task.Points,
+			WasOverdue:  task.OverdueAt != nil,
+			OnTime:      task.CompletedOnTime(now),
+			SeriesID:    task.SeriesID,
+			ScheduledTo: task.ScheduledTo,
+		},
+		Timestamp: now,
+	}
+	if err := s.dispatcher.Publish(ctx, event); err != nil {
+		return nil, err
 	}
 
-	if task.FrequencyValue > 0 && task.FrequencyUnit != "" {
-		nextScheduledDate, err := task.CalculateNextDueDate(now)
+	if series != nil && !series.IsPaused() {
+		nextScheduledDate, ok, err := series.NextDueDate(task.ScheduledTo, now)
 		if err != nil {
 			return nil, err
 		}
-		
-		newTask := &domain.Task{
-			Title:          task.Title,
-			Description:    task.Description,
-			Points:         task.Points,
-			Status:         task.Status,
-			ScheduledTo:    &nextScheduledDate,
-			ScheduledById:  task.ScheduledById,
-			FrequencyValue: task.FrequencyValue,
-			FrequencyUnit:  task.FrequencyUnit,
-			Completed:      false,
-			CompletedById:  nil,
-			TenantID:       task.TenantID,
-			CreatedAt:      now,
-			CreatedById:    userID,
-			UpdatedAt:      now,
-		}
 
-		if err := s.repo.Create(ctx, newTask); err != nil {
-			return nil, err
+		if ok {
+			if _, err := s.spawnNextOccurrence(ctx, task, series, nextScheduledDate); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -233,15 +405,23 @@ func (s *Service) DeleteTask(ctx context.Context, id int64) error {
 		return ErrUserNotAuthenticated
 	}
 
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTasks); err != nil {
+		return err
+	}
+
 	return s.repo.Delete(ctx, id, tenantID)
 }
 
-func (s *Service) GetUpcomingTasks(ctx context.Context, limit int, offset int) ([]domain.Task, error) {
+func (s *Service) GetUpcomingTasks(ctx context.Context, filter TaskFilter, limit int, offset int) ([]domain.Task, error) {
 	tenantID := middleware.GetTenantIDFromContext(ctx)
 	if tenantID == 0 {
 		return nil, ErrUserNotAuthenticated
 	}
 
+	if filter.AssigneeID != nil {
+		return s.repo.GetUpcomingTasksByAssignee(ctx, tenantID, *filter.AssigneeID, limit, offset)
+	}
+
 	return s.repo.GetUpcomingTasks(ctx, tenantID, limit, offset)
 }
 
@@ -264,13 +444,19 @@ func (s *Service) GetCompletedTasksByUser(ctx context.Context, userID int64, lim
 }
 
 func (s *Service) UndoCompleteTask(ctx context.Context, id int64) (*domain.Task, error) {
+	return withinTransaction(ctx, s.transactor, func(ctx context.Context) (*domain.Task, error) {
+		return s.undoCompleteTask(ctx, id)
+	})
+}
+
+func (s *Service) undoCompleteTask(ctx context.Context, id int64) (*domain.Task, error) {
 	userID := middleware.GetUserIDFromContext(ctx)
 	tenantID := middleware.GetTenantIDFromContext(ctx)
 	if userID == 0 || tenantID == 0 {
 		return nil, ErrUserNotAuthenticated
 	}
 
-	task, err := s.repo.GetByID(ctx, id, tenantID)
+	task, err := s.repo.GetByIDForUpdate(ctx, id, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -288,31 +474,44 @@ func (s *Service) UndoCompleteTask(ctx context.Context, id int64) (*domain.Task,
 		return nil, ErrTaskNotCompleted
 	}
 
+	if *completedByID != userID {
+		if err := middleware.Authorize(ctx, middleware.PermissionCompleteTaskForOthers); err != nil {
+			return nil, err
+		}
+	}
+
 	now := time.Now()
-	completionTime := task.UpdatedAt
+	completedAt := task.UpdatedAt
+	if task.CompletedAt != nil {
+		completedAt = *task.CompletedAt
+	}
 
-	if task.FrequencyValue > 0 && task.FrequencyUnit != "" {
-		createdTask, err := s.repo.FindTaskCreatedAfterCompletion(ctx, task, completionTime)
+	if task.SeriesID != nil {
+		createdTask, err := s.repo.FindNextOccurrence(ctx, task.ID, tenantID)
 		if err != nil {
 			return nil, err
 		}
 
 		if createdTask != nil {
-			if createdTask.ScheduledTo != nil {
-				originalScheduledDate, err := task.CalculatePreviousDueDate(*createdTask.ScheduledTo)
-				if err == nil {
-					task.ScheduledTo = &originalScheduledDate
-				}
+			if createdTask.Completed {
+				return nil, ErrNextOccurrenceCompleted
 			}
-			
+
 			if err := s.repo.Delete(ctx, createdTask.ID, tenantID); err != nil {
 				return nil, err
 			}
+
+			if task.RotationID != nil {
+				if err := s.rewindRotation(ctx, task); err != nil {
+					return nil, err
+				}
+			}
 		}
 	}
 
 	task.Completed = false
 	task.CompletedById = nil
+	task.CompletedAt = nil
 	task.UpdatedAt = now
 	task.UpdatedById = &userID
 
@@ -320,19 +519,92 @@ func (s *Service) UndoCompleteTask(ctx context.Context, id int64) (*domain.Task,
 		return nil, err
 	}
 
-	if task.Points > 0 {
-		event := events.Event{
-			Type: events.EventTypeTaskUndone,
-			Payload: events.TaskUndonePayload{
-				CompletedBy: *completedByID,
-				Points:      task.Points,
-			},
-			Timestamp: now,
-		}
-		if err := s.dispatcher.Dispatch(event); err != nil {
-			return nil, err
-		}
+	event := events.Event{
+		Type: events.EventTypeTaskUndone,
+		Payload: events.TaskUndonePayload{
+			TaskID:      task.ID,
+			TaskTitle:   task.Title,
+			CompletedBy: *completedByID,
+			TenantID:    tenantID,
+			Points:      task.Points,
+			WasOverdue:  task.OverdueAt != nil,
+			SeriesID:    task.SeriesID,
+			CompletedAt: completedAt,
+		},
+		Timestamp: now,
+	}
+	if err := s.dispatcher.Publish(ctx, event); err != nil {
+		return nil, err
 	}
 
 	return task, nil
 }
+
+func (s *Service) validateAssignee(ctx context.Context, assigneeID int64, tenantID int64, scheduledTo *time.Time) error {
+	assignee, err := s.userRepo.GetByID(ctx, assigneeID, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if assignee == nil || assignee.Status != "active" {
+		return ErrInvalidAssignee
+	}
+
+	if scheduledTo == nil {
+		return nil
+	}
+
+	available, err := s.availableAssignee(ctx, &assigneeID, tenantID, *scheduledTo)
+	if err != nil {
+		return err
+	}
+
+	if available == nil {
+		return ErrAssigneeUnavailable
+	}
+
+	return nil
+}
+
+func normalizeRecurrenceRule(value *string) (*string, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+
+	rule, err := domain.ParseRecurrenceRule(*value)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := rule.String()
+	return &normalized, nil
+}
+
+func (s *Service) publishAssigned(ctx context.Context, task *domain.Task, assignedBy int64) error {
+	if task.AssigneeID == nil {
+		return nil
+	}
+
+	event := events.Event{
+		Type: events.EventTypeTaskAssigned,
+		Payload: events.TaskAssignedPayload{
+			TaskID:     task.ID,
+			TaskTitle:  task.Title,
+			AssigneeID: *task.AssigneeID,
+			AssignedBy: assignedBy,
+			TenantID:   task.TenantID,
+		},
+		Timestamp: time.Now(),
+	}
+	return s.dispatcher.Publish(ctx, event)
+}
+
+func withinTransaction[T any](ctx context.Context, transactor domain.Transactor, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
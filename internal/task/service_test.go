@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/events"
 	"keep-your-house-clean/internal/platform/middleware"
 	"keep-your-house-clean/internal/task/mocks"
 	"testing"
@@ -17,7 +18,7 @@ func createContextWithUserID(userID int64) context.Context {
 func TestNewService(t *testing.T) {
 	repo := &mocks.MockTaskRepository{}
 	dispatcher := &mocks.MockDispatcher{}
-	service := NewService(repo, dispatcher)
+	service := NewService(repo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, dispatcher, &mocks.MockTransactor{})
 
 	if service == nil {
 		t.Fatal("NewService retornou nil")
@@ -114,7 +115,7 @@ func TestService_CreateTask(t *testing.T) {
 				tt.mockSetup(mockRepo)
 			}
 
-			service := NewService(mockRepo, mockDispatcher)
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, mockDispatcher, &mocks.MockTransactor{})
 			ctx := tt.ctx
 			if userID := middleware.GetUserIDFromContext(ctx); userID > 0 {
 				ctx = middleware.SetTenantIDInContext(ctx, 1)
@@ -208,7 +209,7 @@ func TestService_GetTaskByID(t *testing.T) {
 				tt.mockSetup(mockRepo)
 			}
 
-			service := NewService(mockRepo, mockDispatcher)
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, mockDispatcher, &mocks.MockTransactor{})
 			ctx := createContextWithUserID(1)
 			ctx = middleware.SetTenantIDInContext(ctx, 1)
 			task, err := service.GetTaskByID(ctx, tt.id)
@@ -287,10 +288,10 @@ func TestService_ListTasks(t *testing.T) {
 				tt.mockSetup(mockRepo)
 			}
 
-			service := NewService(mockRepo, mockDispatcher)
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, mockDispatcher, &mocks.MockTransactor{})
 			ctx := createContextWithUserID(1)
 			ctx = middleware.SetTenantIDInContext(ctx, 1)
-			tasks, err := service.ListTasks(ctx)
+			tasks, err := service.ListTasks(ctx, TaskFilter{})
 
 			if tt.expectedError != nil {
 				if err == nil {
@@ -356,72 +357,41 @@ func TestService_UpdateTask(t *testing.T) {
 			},
 		},
 		{
-			name: "sucesso ao marcar como completa e calcular próxima data",
+			name: "erro ao marcar como completa pela atualização",
 			ctx:  createContextWithUserID(1),
 			id:   1,
 			req: UpdateTaskRequest{
 				Completed:   boolPtr(true),
 				CompletedAt: &completedTime,
 			},
-			mockSetup: func(m *mocks.MockTaskRepository) {
-				m.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
-					return &domain.Task{
-						ID:             1,
-						Title:          "Tarefa",
-						FrequencyValue: 7,
-						FrequencyUnit:  domain.UnitDays,
-						Completed:      false,
-					}, nil
-				}
-				m.UpdateFunc = func(ctx context.Context, task *domain.Task) error {
-					return nil
-				}
-			},
-			validateTask: func(t *testing.T, task *domain.Task) {
-				if !task.Completed {
-					t.Error("Completed deve ser true")
-				}
-				if task.CompletedById == nil || *task.CompletedById != 1 {
-					t.Error("CompletedById deve ser 1")
-				}
-				if task.ScheduledTo == nil {
-					t.Error("ScheduledTo não deve ser nil após completar")
-				}
-				expectedNextDate := completedTime.AddDate(0, 0, 7)
-				if task.ScheduledTo != nil && !task.ScheduledTo.Equal(expectedNextDate) {
-					t.Errorf("ScheduledTo esperado %v, obtido %v", expectedNextDate, task.ScheduledTo)
-				}
-			},
+			expectedError: ErrCompletionNotEditable,
 		},
 		{
-			name: "sucesso ao desmarcar como completa",
+			name: "erro ao desmarcar como completa pela atualização",
 			ctx:  createContextWithUserID(1),
 			id:   1,
 			req: UpdateTaskRequest{
 				Completed: boolPtr(false),
 			},
-			mockSetup: func(m *mocks.MockTaskRepository) {
-				userID := int64(1)
-				m.GetByIDFunc = func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
-					return &domain.Task{
-						ID:           1,
-						Title:        "Tarefa",
-						Completed:    true,
-						CompletedById: &userID,
-					}, nil
-				}
-				m.UpdateFunc = func(ctx context.Context, task *domain.Task) error {
-					return nil
-				}
+			expectedError: ErrCompletionNotEditable,
+		},
+		{
+			name: "erro quando membro altera os pontos",
+			ctx:  middleware.SetRoleInContext(createContextWithUserID(1), domain.RoleUser),
+			id:   1,
+			req: UpdateTaskRequest{
+				Points: intPtr(50),
 			},
-			validateTask: func(t *testing.T, task *domain.Task) {
-				if task.Completed {
-					t.Error("Completed deve ser false")
-				}
-				if task.CompletedById != nil {
-					t.Error("CompletedById deve ser nil")
-				}
+			expectedError: &middleware.ForbiddenError{Role: domain.RoleUser, Permission: middleware.PermissionManageTasks},
+		},
+		{
+			name: "erro quando membro altera o responsável",
+			ctx:  middleware.SetRoleInContext(createContextWithUserID(1), domain.RoleUser),
+			id:   1,
+			req: UpdateTaskRequest{
+				AssigneeID: int64Ptr(2),
 			},
+			expectedError: &middleware.ForbiddenError{Role: domain.RoleUser, Permission: middleware.PermissionManageTasks},
 		},
 		{
 			name: "erro quando usuário não autenticado",
@@ -473,7 +443,7 @@ func TestService_UpdateTask(t *testing.T) {
 				tt.mockSetup(mockRepo)
 			}
 
-			service := NewService(mockRepo, mockDispatcher)
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, mockDispatcher, &mocks.MockTransactor{})
 			ctx := tt.ctx
 			if userID := middleware.GetUserIDFromContext(ctx); userID > 0 {
 				ctx = middleware.SetTenantIDInContext(ctx, 1)
@@ -507,25 +477,138 @@ func TestService_UpdateTask(t *testing.T) {
 	}
 }
 
+func TestService_UpdateTask_SeriesRecurrence(t *testing.T) {
+	var updatedSeries *domain.TaskSeries
+	var updatedTask *domain.Task
+
+	mockRepo := &mocks.MockTaskRepository{
+		GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+			return &domain.Task{ID: id, TenantID: tenantID, Title: "Lavar louça", SeriesID: int64Ptr(5), FrequencyValue: 1, FrequencyUnit: domain.UnitWeeks}, nil
+		},
+		UpdateFunc: func(ctx context.Context, task *domain.Task) error {
+			updatedTask = task
+			return nil
+		},
+	}
+	seriesRepo := &mocks.MockTaskSeriesRepository{
+		GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.TaskSeries, error) {
+			return &domain.TaskSeries{ID: id, TenantID: tenantID, Title: "Lavar louça", FrequencyValue: 1, FrequencyUnit: domain.UnitWeeks, AnchorMode: domain.AnchorCompletion}, nil
+		},
+		UpdateFunc: func(ctx context.Context, series *domain.TaskSeries) error {
+			updatedSeries = series
+			return nil
+		},
+	}
+
+	service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, seriesRepo, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+	ctx := middleware.SetTenantIDInContext(createContextWithUserID(1), 1)
+	ctx = middleware.SetRoleInContext(ctx, domain.RoleAdmin)
+
+	frequencyValue := 2
+	anchorMode := domain.AnchorSchedule
+	task, err := service.UpdateTask(ctx, 1, UpdateTaskRequest{FrequencyValue: &frequencyValue, AnchorMode: &anchorMode})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if updatedSeries == nil {
+		t.Fatal("esperado que a série fosse atualizada")
+	}
+	if updatedSeries.FrequencyValue != 2 || updatedSeries.AnchorMode != domain.AnchorSchedule {
+		t.Errorf("série com recorrência inesperada: %+v", updatedSeries)
+	}
+	if updatedTask == nil || task.FrequencyValue != 2 || task.AnchorMode != domain.AnchorSchedule {
+		t.Errorf("tarefa com recorrência inesperada: %+v", task)
+	}
+}
+
+func TestService_SeriesChangesRequireManagePermission(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(s *Service, ctx context.Context) error
+	}{
+		{
+			name: "atualizar série",
+			change: func(s *Service, ctx context.Context) error {
+				_, err := s.UpdateSeries(ctx, 5, UpdateSeriesRequest{Points: intPtr(50)})
+				return err
+			},
+		},
+		{
+			name: "pausar série",
+			change: func(s *Service, ctx context.Context) error {
+				_, err := s.PauseSeries(ctx, 5)
+				return err
+			},
+		},
+		{
+			name: "retomar série",
+			change: func(s *Service, ctx context.Context) error {
+				_, err := s.ResumeSeries(ctx, 5)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			seriesRepo := &mocks.MockTaskSeriesRepository{
+				UpdateFunc: func(ctx context.Context, series *domain.TaskSeries) error {
+					updated = true
+					return nil
+				},
+			}
+
+			service := NewService(&mocks.MockTaskRepository{}, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, seriesRepo, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+			ctx := middleware.SetTenantIDInContext(createContextWithUserID(1), 1)
+			ctx = middleware.SetRoleInContext(ctx, domain.RoleUser)
+
+			err := tt.change(service, ctx)
+			if !middleware.IsForbidden(err) {
+				t.Errorf("erro de permissão esperado, obtido '%v'", err)
+			}
+			if updated {
+				t.Error("série não deveria ser atualizada")
+			}
+		})
+	}
+}
+
 func TestService_DeleteTask(t *testing.T) {
 	tests := []struct {
 		name          string
 		id            int64
+		role          string
 		mockSetup     func(*mocks.MockTaskRepository)
 		expectedError error
 	}{
 		{
 			name: "sucesso ao deletar tarefa",
 			id:   1,
+			role: domain.RoleAdmin,
 			mockSetup: func(m *mocks.MockTaskRepository) {
 				m.DeleteFunc = func(ctx context.Context, id int64, tenantID int64) error {
 					return nil
 				}
 			},
 		},
+		{
+			name: "erro quando usuário não tem permissão",
+			id:   1,
+			role: domain.RoleUser,
+			mockSetup: func(m *mocks.MockTaskRepository) {
+				m.DeleteFunc = func(ctx context.Context, id int64, tenantID int64) error {
+					t.Error("Delete não deveria ser chamado")
+					return nil
+				}
+			},
+			expectedError: &middleware.ForbiddenError{Role: domain.RoleUser, Permission: middleware.PermissionManageTasks},
+		},
 		{
 			name: "erro do repositório",
 			id:   1,
+			role: domain.RoleAdmin,
 			mockSetup: func(m *mocks.MockTaskRepository) {
 				m.DeleteFunc = func(ctx context.Context, id int64, tenantID int64) error {
 					return errors.New("database error")
@@ -543,9 +626,10 @@ func TestService_DeleteTask(t *testing.T) {
 				tt.mockSetup(mockRepo)
 			}
 
-			service := NewService(mockRepo, mockDispatcher)
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, mockDispatcher, &mocks.MockTransactor{})
 			ctx := createContextWithUserID(1)
 			ctx = middleware.SetTenantIDInContext(ctx, 1)
+			ctx = middleware.SetRoleInContext(ctx, tt.role)
 			err := service.DeleteTask(ctx, tt.id)
 
 			if tt.expectedError != nil {
@@ -566,6 +650,579 @@ func TestService_DeleteTask(t *testing.T) {
 	}
 }
 
+func TestService_CompleteTask(t *testing.T) {
+	tests := []struct {
+		name          string
+		role          string
+		assigneeID    *int64
+		expectedError error
+	}{
+		{
+			name: "sucesso ao completar tarefa sem responsável",
+			role: domain.RoleUser,
+		},
+		{
+			name:       "sucesso quando o usuário é o responsável",
+			role:       domain.RoleUser,
+			assigneeID: int64Ptr(1),
+		},
+		{
+			name:          "erro quando o usuário não é o responsável",
+			role:          domain.RoleUser,
+			assigneeID:    int64Ptr(2),
+			expectedError: ErrNotTaskAssignee,
+		},
+		{
+			name:       "admin pode completar tarefa de outro responsável",
+			role:       domain.RoleAdmin,
+			assigneeID: int64Ptr(2),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockTaskRepository{
+				GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return &domain.Task{ID: id, TenantID: tenantID, Title: "Tarefa", AssigneeID: tt.assigneeID}, nil
+				},
+			}
+
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+			ctx := createContextWithUserID(1)
+			ctx = middleware.SetTenantIDInContext(ctx, 1)
+			ctx = middleware.SetRoleInContext(ctx, tt.role)
+			task, err := service.CompleteTask(ctx, 1, CompleteTaskRequest{})
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("erro esperado '%v', obtido '%v'", tt.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("erro inesperado: %v", err)
+				return
+			}
+
+			if !task.Completed || task.CompletedById == nil || *task.CompletedById != 1 {
+				t.Errorf("tarefa deveria estar completada pelo usuário 1")
+			}
+		})
+	}
+}
+
+func TestService_CompleteTask_Rotation(t *testing.T) {
+	points := map[int64]int{1: 30, 2: 50, 3: 10}
+	lastCompletions := map[int64]time.Time{
+		1: time.Now().Add(-time.Hour),
+		2: time.Now().Add(-72 * time.Hour),
+		3: time.Now().Add(-24 * time.Hour),
+	}
+
+	tests := []struct {
+		name             string
+		strategy         domain.RotationStrategy
+		lastAssigneeID   *int64
+		absentIDs        []int64
+		expectedAssignee int64
+	}{
+		{
+			name:             "round-robin passa para o próximo membro",
+			strategy:         domain.RotationRoundRobin,
+			lastAssigneeID:   int64Ptr(1),
+			expectedAssignee: 2,
+		},
+		{
+			name:             "round-robin volta ao primeiro membro",
+			strategy:         domain.RotationRoundRobin,
+			lastAssigneeID:   int64Ptr(3),
+			expectedAssignee: 1,
+		},
+		{
+			name:             "menos pontos escolhe o membro com menor pontuação",
+			strategy:         domain.RotationFewestPoints,
+			lastAssigneeID:   int64Ptr(1),
+			expectedAssignee: 3,
+		},
+		{
+			name:             "menos recente escolhe quem completou há mais tempo",
+			strategy:         domain.RotationLeastRecentlyCompleted,
+			lastAssigneeID:   int64Ptr(1),
+			expectedAssignee: 2,
+		},
+		{
+			name:             "round-robin pula membro ausente",
+			strategy:         domain.RotationRoundRobin,
+			lastAssigneeID:   int64Ptr(1),
+			absentIDs:        []int64{2},
+			expectedAssignee: 3,
+		},
+		{
+			name:             "menos pontos ignora membro em férias",
+			strategy:         domain.RotationFewestPoints,
+			lastAssigneeID:   int64Ptr(1),
+			absentIDs:        []int64{3},
+			expectedAssignee: 1,
+		},
+		{
+			name:           "todos ausentes deixam a ocorrência sem responsável",
+			strategy:       domain.RotationRoundRobin,
+			lastAssigneeID: int64Ptr(1),
+			absentIDs:      []int64{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var createdTask *domain.Task
+			var updatedRotation *domain.TaskRotation
+
+			mockRepo := &mocks.MockTaskRepository{
+				GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return &domain.Task{
+						ID:             id,
+						TenantID:       tenantID,
+						Title:          "Tirar o lixo",
+						FrequencyValue: 1,
+						FrequencyUnit:  domain.UnitDays,
+						AssigneeID:     int64Ptr(1),
+						RotationID:     int64Ptr(10),
+					}, nil
+				},
+				CreateFunc: func(ctx context.Context, task *domain.Task) error {
+					createdTask = task
+					return nil
+				},
+			}
+			mockUserRepo := &mocks.MockUserRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+					return &domain.User{ID: id, TenantID: tenantID, Status: "active", Points: points[id]}, nil
+				},
+			}
+			mockRotationRepo := &mocks.MockTaskRotationRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.TaskRotation, error) {
+					return &domain.TaskRotation{
+						ID:             id,
+						TenantID:       tenantID,
+						Strategy:       tt.strategy,
+						MemberIDs:      []int64{1, 2, 3},
+						LastAssigneeID: tt.lastAssigneeID,
+					}, nil
+				},
+				UpdateFunc: func(ctx context.Context, rotation *domain.TaskRotation) error {
+					updatedRotation = rotation
+					return nil
+				},
+				GetLastCompletionsFunc: func(ctx context.Context, rotationID int64, tenantID int64) (map[int64]time.Time, error) {
+					return lastCompletions, nil
+				},
+			}
+
+			mockAbsenceRepo := &mocks.MockAbsenceRepository{
+				FetchOverlappingFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error) {
+					var absences []domain.Absence
+					for _, userID := range tt.absentIDs {
+						absences = append(absences, domain.Absence{UserID: userID, TenantID: tenantID, StartsOn: time.Now().AddDate(0, 0, -1)})
+					}
+					return absences, nil
+				},
+			}
+
+			service := NewService(mockRepo, mockUserRepo, mockRotationRepo, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, mockAbsenceRepo, &mocks.MockChecklistRepository{}, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+			ctx := createContextWithUserID(1)
+			ctx = middleware.SetTenantIDInContext(ctx, 1)
+			ctx = middleware.SetRoleInContext(ctx, domain.RoleUser)
+
+			if _, err := service.CompleteTask(ctx, 1, CompleteTaskRequest{}); err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if tt.expectedAssignee == 0 {
+				if createdTask == nil || createdTask.AssigneeID != nil {
+					t.Fatal("próxima ocorrência não deveria ter responsável")
+				}
+				if updatedRotation != nil {
+					t.Error("rotação não deveria avançar sem membros disponíveis")
+				}
+				return
+			}
+			if createdTask == nil || createdTask.AssigneeID == nil {
+				t.Fatal("próxima ocorrência deveria ter um responsável")
+			}
+			if *createdTask.AssigneeID != tt.expectedAssignee {
+				t.Errorf("responsável esperado %d, obtido %d", tt.expectedAssignee, *createdTask.AssigneeID)
+			}
+			if createdTask.RotationID == nil || *createdTask.RotationID != 10 {
+				t.Error("próxima ocorrência deveria manter a rotação")
+			}
+			if updatedRotation == nil || updatedRotation.LastAssigneeID == nil || *updatedRotation.LastAssigneeID != tt.expectedAssignee {
+				t.Error("estado da rotação deveria ser atualizado")
+			}
+		})
+	}
+}
+
+func TestService_UndoCompleteTask_Series(t *testing.T) {
+	scheduledTo := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	nextScheduledTo := time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		nextOccurrence  *domain.Task
+		expectedDeleted int64
+		expectedError   error
+	}{
+		{
+			name:            "remove a próxima ocorrência vinculada à tarefa",
+			nextOccurrence:  &domain.Task{ID: 2, PreviousTaskID: int64Ptr(1), SeriesID: int64Ptr(5), ScheduledTo: &nextScheduledTo},
+			expectedDeleted: 2,
+		},
+		{
+			name: "não remove nada quando não há próxima ocorrência",
+		},
+		{
+			name:           "recusa quando a próxima ocorrência já foi concluída",
+			nextOccurrence: &domain.Task{ID: 2, PreviousTaskID: int64Ptr(1), SeriesID: int64Ptr(5), Completed: true},
+			expectedError:  ErrNextOccurrenceCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deletedID int64
+			mockRepo := &mocks.MockTaskRepository{
+				GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return &domain.Task{
+						ID:             id,
+						TenantID:       tenantID,
+						Completed:      true,
+						CompletedById:  int64Ptr(1),
+						FrequencyValue: 1,
+						FrequencyUnit:  domain.UnitWeeks,
+						SeriesID:       int64Ptr(5),
+						ScheduledTo:    &scheduledTo,
+					}, nil
+				},
+				FindNextOccurrenceFunc: func(ctx context.Context, taskID int64, tenantID int64) (*domain.Task, error) {
+					if taskID != 1 {
+						t.Errorf("ocorrência buscada pelo ID errado: %d", taskID)
+					}
+					return tt.nextOccurrence, nil
+				},
+				DeleteFunc: func(ctx context.Context, id int64, tenantID int64) error {
+					deletedID = id
+					return nil
+				},
+			}
+
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+			ctx := createContextWithUserID(1)
+			ctx = middleware.SetTenantIDInContext(ctx, 1)
+
+			task, err := service.UndoCompleteTask(ctx, 1)
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("erro esperado '%v', obtido '%v'", tt.expectedError, err)
+				}
+				if deletedID != 0 {
+					t.Errorf("nenhuma ocorrência deveria ser removida, removida %d", deletedID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if task.Completed {
+				t.Error("tarefa não deveria estar completada")
+			}
+			if deletedID != tt.expectedDeleted {
+				t.Errorf("ID removido esperado %d, obtido %d", tt.expectedDeleted, deletedID)
+			}
+			if task.ScheduledTo == nil || !task.ScheduledTo.Equal(scheduledTo) {
+				t.Errorf("data agendada esperada %v, obtida %v", scheduledTo, task.ScheduledTo)
+			}
+		})
+	}
+}
+
+type recordingTransactor struct {
+	calls     int
+	active    bool
+	pending   []string
+	committed []string
+}
+
+func (r *recordingTransactor) record(t *testing.T, write string) {
+	if !r.active {
+		t.Errorf("escrita %q executada fora da transação", write)
+	}
+	r.pending = append(r.pending, write)
+}
+
+func (r *recordingTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	r.calls++
+	r.active = true
+	r.pending = nil
+	err := fn(ctx)
+	r.active = false
+	if err == nil {
+		r.committed = append(r.committed, r.pending...)
+	}
+	return err
+}
+
+func TestService_CompleteTask_Transaction(t *testing.T) {
+	tests := []struct {
+		name              string
+		createErr         error
+		publishErr        error
+		expectedErr       bool
+		expectedCommitted []string
+	}{
+		{
+			name:              "confirma conclusão, evento e próxima ocorrência juntos",
+			expectedCommitted: []string{"series", "update", "publish", "create"},
+		},
+		{
+			name:        "desfaz a conclusão quando a próxima ocorrência falha",
+			createErr:   errors.New("create error"),
+			expectedErr: true,
+		},
+		{
+			name:        "desfaz a conclusão quando a publicação do evento falha",
+			publishErr:  errors.New("publish error"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactor := &recordingTransactor{}
+			mockRepo := &mocks.MockTaskRepository{
+				GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return &domain.Task{
+						ID:             id,
+						TenantID:       tenantID,
+						Title:          "Regar as plantas",
+						FrequencyValue: 1,
+						FrequencyUnit:  domain.UnitDays,
+					}, nil
+				},
+				UpdateFunc: func(ctx context.Context, task *domain.Task) error {
+					transactor.record(t, "update")
+					return nil
+				},
+				CreateFunc: func(ctx context.Context, task *domain.Task) error {
+					transactor.record(t, "create")
+					return tt.createErr
+				},
+			}
+			mockSeriesRepo := &mocks.MockTaskSeriesRepository{
+				CreateFunc: func(ctx context.Context, series *domain.TaskSeries) error {
+					transactor.record(t, "series")
+					series.ID = 5
+					return nil
+				},
+			}
+			mockDispatcher := &mocks.MockDispatcher{
+				DispatchFunc: func(event events.Event) error {
+					transactor.record(t, "publish")
+					return tt.publishErr
+				},
+			}
+
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, mockSeriesRepo, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, mockDispatcher, transactor)
+			ctx := createContextWithUserID(1)
+			ctx = middleware.SetTenantIDInContext(ctx, 1)
+
+			_, err := service.CompleteTask(ctx, 1, CompleteTaskRequest{})
+			if (err != nil) != tt.expectedErr {
+				t.Fatalf("erro esperado: %v, obtido: %v", tt.expectedErr, err)
+			}
+
+			if transactor.calls != 1 {
+				t.Errorf("esperada 1 transação, obtidas %d", transactor.calls)
+			}
+			if len(transactor.committed) != len(tt.expectedCommitted) {
+				t.Fatalf("escritas confirmadas esperadas %v, obtidas %v", tt.expectedCommitted, transactor.committed)
+			}
+			for i, write := range tt.expectedCommitted {
+				if transactor.committed[i] != write {
+					t.Errorf("escritas confirmadas esperadas %v, obtidas %v", tt.expectedCommitted, transactor.committed)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestService_UndoCompleteTask_Transaction(t *testing.T) {
+	tests := []struct {
+		name              string
+		updateErr         error
+		expectedErr       bool
+		expectedCommitted []string
+	}{
+		{
+			name:              "confirma remoção da ocorrência, rotação e tarefa juntas",
+			expectedCommitted: []string{"delete", "rotation", "update", "publish"},
+		},
+		{
+			name:        "mantém a próxima ocorrência quando a atualização falha",
+			updateErr:   errors.New("update error"),
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactor := &recordingTransactor{}
+			mockRepo := &mocks.MockTaskRepository{
+				GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return &domain.Task{
+						ID:             id,
+						TenantID:       tenantID,
+						Completed:      true,
+						CompletedById:  int64Ptr(1),
+						FrequencyValue: 1,
+						FrequencyUnit:  domain.UnitDays,
+						SeriesID:       int64Ptr(5),
+						RotationID:     int64Ptr(10),
+						AssigneeID:     int64Ptr(1),
+					}, nil
+				},
+				FindNextOccurrenceFunc: func(ctx context.Context, taskID int64, tenantID int64) (*domain.Task, error) {
+					return &domain.Task{ID: 2, PreviousTaskID: int64Ptr(1), SeriesID: int64Ptr(5)}, nil
+				},
+				DeleteFunc: func(ctx context.Context, id int64, tenantID int64) error {
+					transactor.record(t, "delete")
+					return nil
+				},
+				UpdateFunc: func(ctx context.Context, task *domain.Task) error {
+					transactor.record(t, "update")
+					return tt.updateErr
+				},
+			}
+			mockRotationRepo := &mocks.MockTaskRotationRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.TaskRotation, error) {
+					return &domain.TaskRotation{ID: id, TenantID: tenantID, MemberIDs: []int64{1, 2}}, nil
+				},
+				UpdateFunc: func(ctx context.Context, rotation *domain.TaskRotation) error {
+					transactor.record(t, "rotation")
+					return nil
+				},
+			}
+			mockDispatcher := &mocks.MockDispatcher{
+				DispatchFunc: func(event events.Event) error {
+					transactor.record(t, "publish")
+					return nil
+				},
+			}
+
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, mockRotationRepo, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, &mocks.MockChecklistRepository{}, mockDispatcher, transactor)
+			ctx := createContextWithUserID(1)
+			ctx = middleware.SetTenantIDInContext(ctx, 1)
+
+			_, err := service.UndoCompleteTask(ctx, 1)
+			if (err != nil) != tt.expectedErr {
+				t.Fatalf("erro esperado: %v, obtido: %v", tt.expectedErr, err)
+			}
+
+			if transactor.calls != 1 {
+				t.Errorf("esperada 1 transação, obtidas %d", transactor.calls)
+			}
+			if len(transactor.committed) != len(tt.expectedCommitted) {
+				t.Fatalf("escritas confirmadas esperadas %v, obtidas %v", tt.expectedCommitted, transactor.committed)
+			}
+			for i, write := range tt.expectedCommitted {
+				if transactor.committed[i] != write {
+					t.Errorf("escritas confirmadas esperadas %v, obtidas %v", tt.expectedCommitted, transactor.committed)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestOverdueJob_Run(t *testing.T) {
+	scheduled := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		overdue        []domain.Task
+		repoErr        error
+		expectedEvents int
+		expectError    bool
+	}{
+		{
+			name: "emite evento para cada tarefa atrasada",
+			overdue: []domain.Task{
+				{ID: 1, TenantID: 1, ScheduledTo: &scheduled, AssigneeID: int64Ptr(2)},
+				{ID: 2, TenantID: 1, ScheduledTo: &scheduled},
+			},
+			expectedEvents: 2,
+		},
+		{
+			name:           "nenhuma tarefa atrasada",
+			overdue:        []domain.Task{},
+			expectedEvents: 0,
+		},
+		{
+			name:        "erro do repositório",
+			repoErr:     errors.New("database error"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mocks.MockTaskRepository{
+				MarkOverdueFunc: func(ctx context.Context, now time.Time) ([]domain.Task, error) {
+					return tt.overdue, tt.repoErr
+				},
+			}
+
+			var dispatched []events.Event
+			dispatcher := &mocks.MockDispatcher{
+				DispatchFunc: func(event events.Event) error {
+					dispatched = append(dispatched, event)
+					return nil
+				},
+			}
+
+			err := NewOverdueJob(mockRepo, dispatcher, &mocks.MockTransactor{}).Run(context.Background())
+			if tt.expectError {
+				if err == nil {
+					t.Error("esperava erro mas não recebeu")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if len(dispatched) != tt.expectedEvents {
+				t.Fatalf("eventos esperados %d, obtidos %d", tt.expectedEvents, len(dispatched))
+			}
+			for i, event := range dispatched {
+				if event.Type != events.EventTypeTaskOverdue {
+					t.Errorf("tipo de evento esperado %s, obtido %s", events.EventTypeTaskOverdue, event.Type)
+				}
+				payload := event.Payload.(events.TaskOverduePayload)
+				if payload.TaskID != tt.overdue[i].ID {
+					t.Errorf("ID da tarefa esperado %d, obtido %d", tt.overdue[i].ID, payload.TaskID)
+				}
+			}
+		})
+	}
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -573,3 +1230,370 @@ func stringPtr(s string) *string {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func TestService_CompleteTask_CopiesChecklist(t *testing.T) {
+	checkedAt := time.Now().Add(-time.Hour)
+	previousItems := []domain.ChecklistItem{
+		{ID: 3, TenantID: 1, TaskID: 1, Position: 2, Title: "Lavar a louça", CheckedAt: &checkedAt, CheckedById: int64Ptr(2)},
+		{ID: 4, TenantID: 1, TaskID: 1, Position: 3, Title: "Passar pano no chão"},
+		{ID: 2, TenantID: 1, TaskID: 1, Position: 1, Title: "Limpar a bancada", CheckedAt: &checkedAt, CheckedById: int64Ptr(1)},
+	}
+
+	var copies []domain.ChecklistItem
+	mockRepo := &mocks.MockTaskRepository{
+		GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+			return &domain.Task{
+				ID:             id,
+				TenantID:       tenantID,
+				Title:          "Limpar a cozinha",
+				FrequencyValue: 1,
+				FrequencyUnit:  domain.UnitWeeks,
+			}, nil
+		},
+		CreateFunc: func(ctx context.Context, task *domain.Task) error {
+			task.ID = 9
+			return nil
+		},
+	}
+	mockChecklistRepo := &mocks.MockChecklistRepository{
+		FetchByTaskFunc: func(ctx context.Context, taskID int64, tenantID int64) ([]domain.ChecklistItem, error) {
+			if taskID != 1 {
+				t.Errorf("checklist deveria ser lida da tarefa concluída, obtida tarefa %d", taskID)
+			}
+			return previousItems, nil
+		},
+		CreateFunc: func(ctx context.Context, item *domain.ChecklistItem) error {
+			copies = append(copies, *item)
+			return nil
+		},
+	}
+
+	service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, mockChecklistRepo, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+	ctx := middleware.SetTenantIDInContext(createContextWithUserID(1), 1)
+
+	if _, err := service.CompleteTask(ctx, 1, CompleteTaskRequest{}); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	expectedTitles := []string{"Limpar a bancada", "Lavar a louça", "Passar pano no chão"}
+	if len(copies) != len(expectedTitles) {
+		t.Fatalf("esperados %d itens copiados, obtidos %d", len(expectedTitles), len(copies))
+	}
+	for i, item := range copies {
+		if item.TaskID != 9 {
+			t.Errorf("item %d deveria pertencer à próxima ocorrência, obtida tarefa %d", i, item.TaskID)
+		}
+		if item.Title != expectedTitles[i] || item.Position != i+1 {
+			t.Errorf("item %d: esperado %q na posição %d, obtido %q na posição %d", i, expectedTitles[i], i+1, item.Title, item.Position)
+		}
+		if item.IsChecked() || item.CheckedById != nil {
+			t.Errorf("item %q deveria ser copiado desmarcado", item.Title)
+		}
+	}
+}
+
+func TestService_CheckChecklistItem(t *testing.T) {
+	checkedAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		check         bool
+		completed     bool
+		item          *domain.ChecklistItem
+		expectedError error
+		expectAudit   *domain.ChecklistAction
+	}{
+		{
+			name:        "marca item e registra quem marcou",
+			check:       true,
+			item:        &domain.ChecklistItem{ID: 5, TenantID: 1, TaskID: 1, Position: 1, Title: "Limpar o fogão"},
+			expectAudit: actionPtr(domain.ChecklistChecked),
+		},
+		{
+			name:        "desmarca item e registra quem desmarcou",
+			item:        &domain.ChecklistItem{ID: 5, TenantID: 1, TaskID: 1, Position: 1, Title: "Limpar o fogão", CheckedAt: &checkedAt, CheckedById: int64Ptr(2)},
+			expectAudit: actionPtr(domain.ChecklistUnchecked),
+		},
+		{
+			name:  "marcar item já marcado não gera auditoria",
+			check: true,
+			item:  &domain.ChecklistItem{ID: 5, TenantID: 1, TaskID: 1, Position: 1, Title: "Limpar o fogão", CheckedAt: &checkedAt, CheckedById: int64Ptr(2)},
+		},
+		{
+			name:          "item de outra tarefa",
+			check:         true,
+			item:          &domain.ChecklistItem{ID: 5, TenantID: 1, TaskID: 7, Position: 1, Title: "Limpar o fogão"},
+			expectedError: ErrChecklistItemNotFound,
+		},
+		{
+			name:          "tarefa concluída não aceita alterações",
+			check:         true,
+			completed:     true,
+			item:          &domain.ChecklistItem{ID: 5, TenantID: 1, TaskID: 1, Position: 1, Title: "Limpar o fogão"},
+			expectedError: ErrTaskAlreadyCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var audits []domain.ChecklistAuditEntry
+			mockRepo := &mocks.MockTaskRepository{
+				GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return &domain.Task{ID: id, TenantID: tenantID, Title: "Limpar a cozinha", Completed: tt.completed}, nil
+				},
+			}
+			mockChecklistRepo := &mocks.MockChecklistRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.ChecklistItem, error) {
+					item := *tt.item
+					return &item, nil
+				},
+				RecordAuditFunc: func(ctx context.Context, entry *domain.ChecklistAuditEntry) error {
+					audits = append(audits, *entry)
+					return nil
+				},
+			}
+
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, mockChecklistRepo, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+			ctx := middleware.SetTenantIDInContext(createContextWithUserID(3), 1)
+
+			var item *domain.ChecklistItem
+			var err error
+			if tt.check {
+				item, err = service.CheckChecklistItem(ctx, 1, 5)
+			} else {
+				item, err = service.UncheckChecklistItem(ctx, 1, 5)
+			}
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("esperado erro %v, obtido %v", tt.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if item.IsChecked() != tt.check {
+				t.Errorf("item marcado esperado %v, obtido %v", tt.check, item.IsChecked())
+			}
+
+			if tt.expectAudit == nil {
+				if len(audits) != 0 {
+					t.Errorf("nenhuma auditoria esperada, obtidas %d", len(audits))
+				}
+				return
+			}
+			if len(audits) != 1 {
+				t.Fatalf("esperada 1 auditoria, obtidas %d", len(audits))
+			}
+			if audits[0].Action != *tt.expectAudit || audits[0].UserID == nil || *audits[0].UserID != 3 || audits[0].ItemTitle != "Limpar o fogão" {
+				t.Errorf("auditoria incorreta: %+v", audits[0])
+			}
+		})
+	}
+}
+
+func TestService_ReorderChecklist(t *testing.T) {
+	items := []domain.ChecklistItem{
+		{ID: 1, TenantID: 1, TaskID: 1, Position: 1, Title: "Recolher a roupa"},
+		{ID: 2, TenantID: 1, TaskID: 1, Position: 2, Title: "Dobrar"},
+		{ID: 3, TenantID: 1, TaskID: 1, Position: 3, Title: "Guardar"},
+	}
+
+	tests := []struct {
+		name              string
+		itemIDs           []int64
+		completed         bool
+		expectedError     error
+		expectedPositions map[int64]int
+	}{
+		{
+			name:              "reordena e atualiza apenas posições alteradas",
+			itemIDs:           []int64{3, 1, 2},
+			expectedPositions: map[int64]int{3: 1, 1: 2, 2: 3},
+		},
+		{
+			name:          "exige todos os itens",
+			itemIDs:       []int64{3, 1},
+			expectedError: ErrInvalidChecklistOrder,
+		},
+		{
+			name:          "rejeita itens repetidos",
+			itemIDs:       []int64{1, 1, 2},
+			expectedError: ErrInvalidChecklistOrder,
+		},
+		{
+			name:          "rejeita itens de outra tarefa",
+			itemIDs:       []int64{1, 2, 9},
+			expectedError: ErrInvalidChecklistOrder,
+		},
+		{
+			name:          "tarefa concluída não aceita reordenação",
+			itemIDs:       []int64{3, 1, 2},
+			completed:     true,
+			expectedError: ErrTaskAlreadyCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := make(map[int64]int)
+			mockRepo := &mocks.MockTaskRepository{
+				GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+					return &domain.Task{ID: id, TenantID: tenantID, Title: "Lavar roupa", Completed: tt.completed}, nil
+				},
+			}
+			mockChecklistRepo := &mocks.MockChecklistRepository{
+				FetchByTaskFunc: func(ctx context.Context, taskID int64, tenantID int64) ([]domain.ChecklistItem, error) {
+					return append([]domain.ChecklistItem{}, items...), nil
+				},
+				UpdateFunc: func(ctx context.Context, item *domain.ChecklistItem) error {
+					updated[item.ID] = item.Position
+					return nil
+				},
+			}
+
+			service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, &mocks.MockAbsenceRepository{}, mockChecklistRepo, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+			ctx := middleware.SetTenantIDInContext(createContextWithUserID(1), 1)
+
+			checklist, err := service.ReorderChecklist(ctx, 1, ReorderChecklistRequest{ItemIDs: tt.itemIDs})
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Fatalf("esperado erro %v, obtido %v", tt.expectedError, err)
+				}
+				if len(updated) != 0 {
+					t.Error("nenhum item deveria ser atualizado")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if len(updated) != len(tt.expectedPositions) {
+				t.Errorf("esperadas %d atualizações, obtidas %d", len(tt.expectedPositions), len(updated))
+			}
+			for i, item := range checklist.Items {
+				if item.ID != tt.itemIDs[i] || item.Position != tt.expectedPositions[item.ID] {
+					t.Errorf("posição %d: esperado item %d na posição %d, obtido item %d na posição %d", i, tt.itemIDs[i], tt.expectedPositions[tt.itemIDs[i]], item.ID, item.Position)
+				}
+			}
+		})
+	}
+}
+
+func actionPtr(action domain.ChecklistAction) *domain.ChecklistAction {
+	return &action
+}
+
+func TestService_CreateTask_AssigneeAvailability(t *testing.T) {
+	absenceEnd := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	absences := []domain.Absence{{UserID: 2, StartsOn: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), EndsOn: &absenceEnd}}
+	duringAbsence := time.Date(2024, 3, 5, 9, 0, 0, 0, time.UTC)
+	afterAbsence := time.Date(2024, 3, 12, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		scheduledTo   *time.Time
+		expectedError error
+	}{
+		{
+			name:          "erro quando responsável está ausente na data agendada",
+			scheduledTo:   &duringAbsence,
+			expectedError: ErrAssigneeUnavailable,
+		},
+		{
+			name:        "sucesso quando responsável está disponível na data agendada",
+			scheduledTo: &afterAbsence,
+		},
+		{
+			name: "sucesso quando a tarefa não tem data agendada",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepo := &mocks.MockUserRepository{
+				GetByIDFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+					return &domain.User{ID: id, TenantID: tenantID, Status: "active"}, nil
+				},
+			}
+			mockAbsenceRepo := &mocks.MockAbsenceRepository{
+				FetchOverlappingFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error) {
+					return absences, nil
+				},
+			}
+
+			service := NewService(&mocks.MockTaskRepository{}, mockUserRepo, &mocks.MockTaskRotationRepository{}, &mocks.MockTaskSeriesRepository{}, &mocks.MockStreakRepository{}, mockAbsenceRepo, &mocks.MockChecklistRepository{}, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+			ctx := middleware.SetTenantIDInContext(createContextWithUserID(1), 1)
+
+			_, err := service.CreateTask(ctx, CreateTaskRequest{Title: "Lavar louça", Points: 5, AssigneeID: int64Ptr(2), ScheduledTo: tt.scheduledTo})
+
+			if tt.expectedError != nil {
+				if !errors.Is(err, tt.expectedError) {
+					t.Errorf("erro esperado '%v', obtido '%v'", tt.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("erro inesperado: %v", err)
+			}
+		})
+	}
+}
+
+func TestService_CompleteTask_SkipsAbsentAssignee(t *testing.T) {
+	scheduledTo := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	absences := []domain.Absence{{UserID: 2, StartsOn: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)}}
+
+	var created *domain.Task
+	mockRepo := &mocks.MockTaskRepository{
+		GetByIDForUpdateFunc: func(ctx context.Context, id int64, tenantID int64) (*domain.Task, error) {
+			return &domain.Task{
+				ID:             id,
+				TenantID:       tenantID,
+				Title:          "Regar as plantas",
+				ScheduledTo:    &scheduledTo,
+				AssigneeID:     int64Ptr(2),
+				FrequencyValue: 1,
+				FrequencyUnit:  domain.UnitDays,
+				AnchorMode:     domain.AnchorSchedule,
+			}, nil
+		},
+		CreateFunc: func(ctx context.Context, task *domain.Task) error {
+			created = task
+			return nil
+		},
+	}
+	mockSeriesRepo := &mocks.MockTaskSeriesRepository{
+		CreateFunc: func(ctx context.Context, series *domain.TaskSeries) error {
+			series.ID = 5
+			return nil
+		},
+	}
+	mockAbsenceRepo := &mocks.MockAbsenceRepository{
+		FetchOverlappingFunc: func(ctx context.Context, tenantID int64, from time.Time, to time.Time) ([]domain.Absence, error) {
+			return absences, nil
+		},
+	}
+
+	service := NewService(mockRepo, &mocks.MockUserRepository{}, &mocks.MockTaskRotationRepository{}, mockSeriesRepo, &mocks.MockStreakRepository{}, mockAbsenceRepo, &mocks.MockChecklistRepository{}, &mocks.MockDispatcher{}, &mocks.MockTransactor{})
+	ctx := middleware.SetTenantIDInContext(createContextWithUserID(2), 1)
+
+	if _, err := service.CompleteTask(ctx, 1, CompleteTaskRequest{}); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if created == nil {
+		t.Fatal("esperada a criação da próxima ocorrência")
+	}
+	if created.AssigneeID != nil {
+		t.Errorf("responsável esperado nil durante a ausência, obtido %d", *created.AssigneeID)
+	}
+}
@@ -0,0 +1,46 @@
+package task
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
+
+func (s *Service) GetTaskStreak(ctx context.Context, id int64) (*domain.Streak, error) {
+	task, err := s.GetTaskByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.SeriesID == nil {
+		if !task.IsRecurring() {
+			return nil, ErrSeriesNotFound
+		}
+		return domain.NewStreak(task.TenantID, domain.StreakSeriesOnTime, 0), nil
+	}
+
+	return s.seriesStreak(ctx, *task.SeriesID, task.TenantID)
+}
+
+func (s *Service) GetSeriesStreak(ctx context.Context, id int64) (*domain.Streak, error) {
+	series, err := s.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.seriesStreak(ctx, series.ID, series.TenantID)
+}
+
+func (s *Service) seriesStreak(ctx context.Context, seriesID int64, tenantID int64) (*domain.Streak, error) {
+	streak, err := s.streakRepo.Get(ctx, domain.StreakSeriesOnTime, seriesID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if streak == nil {
+		streak = domain.NewStreak(tenantID, domain.StreakSeriesOnTime, seriesID)
+	}
+
+	streak.Current = streak.CurrentAt(time.Now())
+	return streak, nil
+}
@@ -0,0 +1,9 @@
+package tenant
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrTenantNotFound       = errors.New("tenant not found")
+	ErrDomainExists         = errors.New("domain already exists")
+)
@@ -2,10 +2,12 @@ package tenant
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/platform/middleware"
 )
 
 type Handler struct {
@@ -17,7 +19,15 @@ func NewHandler(service *Service) *Handler {
 }
 
 func (h *Handler) RegisterRoutes(r chi.Router) {
-	r.Route("/api/v1/tenants", func(r chi.Router) {
+	r.Route("/api/v1/tenant", func(r chi.Router) {
+		r.Get("/", h.GetCurrentTenant)
+		r.With(middleware.RequirePermission(middleware.PermissionManageOwnTenant)).Put("/", h.UpdateCurrentTenant)
+	})
+}
+
+func (h *Handler) RegisterAdminRoutes(r chi.Router) {
+	r.Route("/api/v1/admin/tenants", func(r chi.Router) {
+		r.Use(middleware.RequirePermission(middleware.PermissionManageTenants))
 		r.Get("/", h.ListTenants)
 		r.Get("/{id}", h.GetTenant)
 		r.Get("/domain/{domain}", h.GetTenantByDomain)
@@ -27,6 +37,32 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 	})
 }
 
+func (h *Handler) GetCurrentTenant(w http.ResponseWriter, r *http.Request) {
+	tenant, err := h.service.GetCurrentTenant(r.Context())
+	if err != nil {
+		respondWithServiceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, tenant)
+}
+
+func (h *Handler) UpdateCurrentTenant(w http.ResponseWriter, r *http.Request) {
+	var req UpdateTenantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	tenant, err := h.service.UpdateCurrentTenant(r.Context(), req)
+	if err != nil {
+		respondWithServiceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, tenant)
+}
+
 func (h *Handler) CreateTenant(w http.ResponseWriter, r *http.Request) {
 	var req CreateTenantRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -36,11 +72,7 @@ func (h *Handler) CreateTenant(w http.ResponseWriter, r *http.Request) {
 
 	tenant, err := h.service.CreateTenant(r.Context(), req)
 	if err != nil {
-		if err.Error() == "domain already exists" {
-			respondWithError(w, http.StatusConflict, err.Error())
-			return
-		}
-		respondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithServiceError(w, err)
 		return
 	}
 
@@ -57,11 +89,7 @@ func (h *Handler) GetTenant(w http.ResponseWriter, r *http.Request) {
 
 	tenant, err := h.service.GetTenantByID(r.Context(), id)
 	if err != nil {
-		if err.Error() == "tenant not found" {
-			respondWithError(w, http.StatusNotFound, err.Error())
-			return
-		}
-		respondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithServiceError(w, err)
 		return
 	}
 
@@ -77,11 +105,7 @@ func (h *Handler) GetTenantByDomain(w http.ResponseWriter, r *http.Request) {
 
 	tenant, err := h.service.GetTenantByDomain(r.Context(), domain)
 	if err != nil {
-		if err.Error() == "tenant not found" {
-			respondWithError(w, http.StatusNotFound, err.Error())
-			return
-		}
-		respondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithServiceError(w, err)
 		return
 	}
 
@@ -91,7 +115,7 @@ func (h *Handler) GetTenantByDomain(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) ListTenants(w http.ResponseWriter, r *http.Request) {
 	tenants, err := h.service.ListTenants(r.Context())
 	if err != nil {
-		respondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithServiceError(w, err)
 		return
 	}
 
@@ -114,15 +138,7 @@ func (h *Handler) UpdateTenant(w http.ResponseWriter, r *http.Request) {
 
 	tenant, err := h.service.UpdateTenant(r.Context(), id, req)
 	if err != nil {
-		if err.Error() == "tenant not found" {
-			respondWithError(w, http.StatusNotFound, err.Error())
-			return
-		}
-		if err.Error() == "domain already exists" {
-			respondWithError(w, http.StatusConflict, err.Error())
-			return
-		}
-		respondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithServiceError(w, err)
 		return
 	}
 
@@ -138,17 +154,28 @@ func (h *Handler) DeleteTenant(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteTenant(r.Context(), id); err != nil {
-		if err.Error() == "tenant not found" {
-			respondWithError(w, http.StatusNotFound, err.Error())
-			return
-		}
-		respondWithError(w, http.StatusInternalServerError, err.Error())
+		respondWithServiceError(w, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func respondWithServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrUserNotAuthenticated):
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+	case middleware.IsForbidden(err):
+		respondWithError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ErrTenantNotFound):
+		respondWithError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, ErrDomainExists):
+		respondWithError(w, http.StatusConflict, err.Error())
+	default:
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
 func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -2,8 +2,10 @@ package tenant
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
 	"time"
 )
 
@@ -15,13 +17,54 @@ func NewService(repo domain.TenantRepository) *Service {
 	return &Service{repo: repo}
 }
 
+func (s *Service) GetCurrentTenant(ctx context.Context) (*domain.Tenant, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	tenant, err := s.repo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+
+	return tenant, nil
+}
+
+func (s *Service) UpdateCurrentTenant(ctx context.Context, req UpdateTenantRequest) (*domain.Tenant, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageOwnTenant); err != nil {
+		return nil, err
+	}
+
+	if req.Status != nil {
+		if err := middleware.Authorize(ctx, middleware.PermissionManageTenants); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.updateTenant(ctx, tenantID, req)
+}
+
 func (s *Service) CreateTenant(ctx context.Context, req CreateTenantRequest) (*domain.Tenant, error) {
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTenants); err != nil {
+		return nil, err
+	}
+
 	existingTenant, err := s.repo.GetByDomain(ctx, req.Domain)
 	if err != nil {
 		return nil, err
 	}
 	if existingTenant != nil {
-		return nil, errors.New("domain already exists")
+		return nil, ErrDomainExists
 	}
 
 	now := time.Now()
@@ -41,32 +84,44 @@ func (s *Service) CreateTenant(ctx context.Context, req CreateTenantRequest) (*d
 }
 
 func (s *Service) GetTenantByID(ctx context.Context, id int64) (*domain.Tenant, error) {
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTenants); err != nil {
+		return nil, err
+	}
+
 	tenant, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	if tenant == nil {
-		return nil, errors.New("tenant not found")
+		return nil, ErrTenantNotFound
 	}
 
 	return tenant, nil
 }
 
 func (s *Service) GetTenantByDomain(ctx context.Context, domain string) (*domain.Tenant, error) {
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTenants); err != nil {
+		return nil, err
+	}
+
 	tenant, err := s.repo.GetByDomain(ctx, domain)
 	if err != nil {
 		return nil, err
 	}
 
 	if tenant == nil {
-		return nil, errors.New("tenant not found")
+		return nil, ErrTenantNotFound
 	}
 
 	return tenant, nil
 }
 
 func (s *Service) ListTenants(ctx context.Context) ([]domain.Tenant, error) {
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTenants); err != nil {
+		return nil, err
+	}
+
 	tenants, err := s.repo.FetchAll(ctx)
 	if err != nil {
 		return nil, err
@@ -76,13 +131,36 @@ func (s *Service) ListTenants(ctx context.Context) ([]domain.Tenant, error) {
 }
 
 func (s *Service) UpdateTenant(ctx context.Context, id int64, req UpdateTenantRequest) (*domain.Tenant, error) {
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTenants); err != nil {
+		return nil, err
+	}
+
+	return s.updateTenant(ctx, id, req)
+}
+
+func (s *Service) DeleteTenant(ctx context.Context, id int64) error {
+	if err := middleware.Authorize(ctx, middleware.PermissionManageTenants); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenantNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) updateTenant(ctx context.Context, id int64, req UpdateTenantRequest) (*domain.Tenant, error) {
 	tenant, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	if tenant == nil {
-		return nil, errors.New("tenant not found")
+		return nil, ErrTenantNotFound
 	}
 
 	if req.Name != nil {
@@ -94,7 +172,7 @@ func (s *Service) UpdateTenant(ctx context.Context, id int64, req UpdateTenantRe
 			return nil, err
 		}
 		if existingTenant != nil && existingTenant.ID != id {
-			return nil, errors.New("domain already exists")
+			return nil, ErrDomainExists
 		}
 		tenant.Domain = *req.Domain
 	}
@@ -111,10 +189,6 @@ func (s *Service) UpdateTenant(ctx context.Context, id int64, req UpdateTenantRe
 	return tenant, nil
 }
 
-func (s *Service) DeleteTenant(ctx context.Context, id int64) error {
-	return s.repo.Delete(ctx, id)
-}
-
 func getStatusOrDefault(status string) string {
 	if status == "" {
 		return "active"
@@ -0,0 +1,356 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"strings"
+	"time"
+)
+
+const absenceDateLayout = "2006-01-02"
+
+func (s *Service) GetAvailability(ctx context.Context, id int64) (*AvailabilityResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if _, err := s.getMember(ctx, id, tenantID); err != nil {
+		return nil, err
+	}
+
+	absences, err := s.absenceRepo.FetchByUser(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAvailability(id, absences, time.Now()), nil
+}
+
+func (s *Service) ListAbsences(ctx context.Context, id int64) ([]domain.Absence, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if _, err := s.getMember(ctx, id, tenantID); err != nil {
+		return nil, err
+	}
+
+	absences, err := s.absenceRepo.FetchByUser(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if absences == nil {
+		absences = []domain.Absence{}
+	}
+
+	return absences, nil
+}
+
+func (s *Service) CreateAbsence(ctx context.Context, id int64, req AbsenceRequest) (*domain.Absence, error) {
+	callerID, tenantID, err := s.authorizeAbsenceChange(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getMember(ctx, id, tenantID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	absence := &domain.Absence{
+		TenantID:    tenantID,
+		UserID:      id,
+		CreatedAt:   now,
+		CreatedById: &callerID,
+		UpdatedAt:   now,
+	}
+	if err := applyAbsenceRequest(absence, req); err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.lockMember(ctx, id, tenantID); err != nil {
+			return err
+		}
+
+		if err := s.ensureNoOverlap(ctx, absence); err != nil {
+			return err
+		}
+
+		if err := s.absenceRepo.Create(ctx, absence); err != nil {
+			return err
+		}
+
+		return s.unassignPendingTasks(ctx, absence, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return absence, nil
+}
+
+func (s *Service) UpdateAbsence(ctx context.Context, id int64, absenceID int64, req AbsenceRequest) (*domain.Absence, error) {
+	_, tenantID, err := s.authorizeAbsenceChange(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	absence, err := s.getAbsence(ctx, id, absenceID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyAbsenceRequest(absence, req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	absence.UpdatedAt = now
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.lockMember(ctx, id, tenantID); err != nil {
+			return err
+		}
+
+		if err := s.ensureNoOverlap(ctx, absence); err != nil {
+			return err
+		}
+
+		if err := s.absenceRepo.Update(ctx, absence); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrAbsenceNotFound
+			}
+			return err
+		}
+
+		return s.unassignPendingTasks(ctx, absence, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return absence, nil
+}
+
+func (s *Service) DeleteAbsence(ctx context.Context, id int64, absenceID int64) error {
+	_, tenantID, err := s.authorizeAbsenceChange(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.getAbsence(ctx, id, absenceID, tenantID); err != nil {
+		return err
+	}
+
+	if err := s.absenceRepo.Delete(ctx, absenceID, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrAbsenceNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) SetVacation(ctx context.Context, id int64, req VacationRequest) (*AvailabilityResponse, error) {
+	callerID, tenantID, err := s.authorizeAbsenceChange(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getMember(ctx, id, tenantID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	today := domain.CalendarDay(now)
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.lockMember(ctx, id, tenantID); err != nil {
+			return err
+		}
+
+		absences, err := s.absenceRepo.FetchByUser(ctx, id, tenantID)
+		if err != nil {
+			return err
+		}
+
+		var vacation *domain.Absence
+		for i := range absences {
+			if absences[i].IsVacation() {
+				vacation = &absences[i]
+				break
+			}
+		}
+
+		if req.Enabled {
+			if vacation != nil {
+				return nil
+			}
+			vacation = &domain.Absence{
+				TenantID:    tenantID,
+				UserID:      id,
+				StartsOn:    today,
+				Reason:      strings.TrimSpace(req.Reason),
+				CreatedAt:   now,
+				CreatedById: &callerID,
+				UpdatedAt:   now,
+			}
+			if err := s.absenceRepo.Create(ctx, vacation); err != nil {
+				return err
+			}
+			return s.unassignPendingTasks(ctx, vacation, now)
+		}
+
+		if vacation == nil {
+			return nil
+		}
+
+		if !domain.CalendarDay(vacation.StartsOn).Before(today) {
+			return s.absenceRepo.Delete(ctx, vacation.ID, tenantID)
+		}
+
+		yesterday := today.AddDate(0, 0, -1)
+		vacation.EndsOn = &yesterday
+		vacation.UpdatedAt = now
+		return s.absenceRepo.Update(ctx, vacation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	absences, err := s.absenceRepo.FetchByUser(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAvailability(id, absences, now), nil
+}
+
+func (s *Service) authorizeAbsenceChange(ctx context.Context, id int64) (int64, int64, error) {
+	callerID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if callerID == 0 || tenantID == 0 {
+		return 0, 0, ErrUserNotAuthenticated
+	}
+
+	if id != callerID {
+		if err := middleware.Authorize(ctx, middleware.PermissionManageUsers); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return callerID, tenantID, nil
+}
+
+func (s *Service) getMember(ctx context.Context, id int64, tenantID int64) (*domain.User, error) {
+	user, err := s.repo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+func (s *Service) lockMember(ctx context.Context, id int64, tenantID int64) error {
+	user, err := s.repo.GetByIDForUpdate(ctx, id, tenantID)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (s *Service) getAbsence(ctx context.Context, id int64, absenceID int64, tenantID int64) (*domain.Absence, error) {
+	absence, err := s.absenceRepo.GetByID(ctx, absenceID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if absence == nil || absence.UserID != id {
+		return nil, ErrAbsenceNotFound
+	}
+
+	return absence, nil
+}
+
+func (s *Service) ensureNoOverlap(ctx context.Context, absence *domain.Absence) error {
+	existing, err := s.absenceRepo.FetchByUser(ctx, absence.UserID, absence.TenantID)
+	if err != nil {
+		return err
+	}
+
+	for i := range existing {
+		if existing[i].ID != absence.ID && existing[i].Overlaps(absence) {
+			return ErrAbsenceOverlap
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) unassignPendingTasks(ctx context.Context, absence *domain.Absence, now time.Time) error {
+	from := domain.CalendarDay(absence.StartsOn)
+	var to *time.Time
+	if absence.EndsOn != nil {
+		end := domain.CalendarDay(*absence.EndsOn).AddDate(0, 0, 1)
+		to = &end
+	}
+
+	return s.taskRepo.UnassignPending(ctx, absence.TenantID, absence.UserID, from, to, now)
+}
+
+func applyAbsenceRequest(absence *domain.Absence, req AbsenceRequest) error {
+	startsOn, err := time.Parse(absenceDateLayout, req.StartsOn)
+	if err != nil {
+		return ErrInvalidAbsence
+	}
+
+	var endsOn *time.Time
+	if req.EndsOn != nil {
+		parsed, err := time.Parse(absenceDateLayout, *req.EndsOn)
+		if err != nil || parsed.Before(startsOn) {
+			return ErrInvalidAbsence
+		}
+		endsOn = &parsed
+	}
+
+	absence.StartsOn = startsOn
+	absence.EndsOn = endsOn
+	absence.Reason = strings.TrimSpace(req.Reason)
+	return nil
+}
+
+func buildAvailability(id int64, absences []domain.Absence, now time.Time) *AvailabilityResponse {
+	if absences == nil {
+		absences = []domain.Absence{}
+	}
+
+	response := &AvailabilityResponse{
+		UserID:    id,
+		Available: !domain.IsAbsent(absences, id, now),
+		Absences:  absences,
+	}
+	for i := range absences {
+		if absences[i].IsVacation() && absences[i].Covers(now) {
+			response.OnVacation = true
+		}
+	}
+
+	return response
+}
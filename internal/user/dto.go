@@ -1,12 +1,14 @@
 package user
 
-import "time"
+import (
+	"keep-your-house-clean/internal/domain"
+	"time"
+)
 
 type CreateUserRequest struct {
 	Name     string `json:"name"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
-	TenantID int64  `json:"tenant_id"`
 	Points   int    `json:"points"`
 	Role     string `json:"role"`
 	Status   string `json:"status"`
@@ -21,3 +23,55 @@ type UpdateUserRequest struct {
 	Status      *string    `json:"status"`
 	LastLoginAt *time.Time `json:"last_login_at"`
 }
+
+type PointsStatementResponse struct {
+	UserID  int64                `json:"user_id"`
+	Balance int                  `json:"balance"`
+	Entries []domain.PointsEntry `json:"entries"`
+}
+
+type LeaderboardQuery struct {
+	Period domain.LeaderboardPeriod
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+	Now    time.Time
+}
+
+type LeaderboardEntry struct {
+	Rank           int    `json:"rank"`
+	UserID         int64  `json:"user_id"`
+	Name           string `json:"name"`
+	Points         int    `json:"points"`
+	PreviousRank   int    `json:"previous_rank"`
+	PreviousPoints int    `json:"previous_points"`
+	PointsDelta    int    `json:"points_delta"`
+	RankChange     int    `json:"rank_change"`
+}
+
+type LeaderboardResponse struct {
+	Period        domain.LeaderboardPeriod `json:"period"`
+	Start         time.Time                `json:"start"`
+	End           time.Time                `json:"end"`
+	PreviousStart time.Time                `json:"previous_start"`
+	PreviousEnd   time.Time                `json:"previous_end"`
+	Entries       []LeaderboardEntry       `json:"entries"`
+}
+
+type AbsenceRequest struct {
+	StartsOn string  `json:"starts_on"`
+	EndsOn   *string `json:"ends_on"`
+	Reason   string  `json:"reason"`
+}
+
+type VacationRequest struct {
+	Enabled bool   `json:"enabled"`
+	Reason  string `json:"reason"`
+}
+
+type AvailabilityResponse struct {
+	UserID     int64            `json:"user_id"`
+	Available  bool             `json:"available"`
+	OnVacation bool             `json:"on_vacation"`
+	Absences   []domain.Absence `json:"absences"`
+}
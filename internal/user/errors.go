@@ -0,0 +1,16 @@
+package user
+
+import "errors"
+
+var (
+	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrEmailExists          = errors.New("email already exists for this tenant")
+	ErrInvalidRole          = errors.New("invalid role")
+	ErrPasswordHashFailed   = errors.New("failed to hash password")
+	ErrUseChangePassword    = errors.New("use /api/v1/auth/change-password to change your own password")
+	ErrInvalidLeaderboard   = errors.New("invalid leaderboard period or date range")
+	ErrAbsenceNotFound      = errors.New("absence not found")
+	ErrInvalidAbsence       = errors.New("starts_on and ends_on must be YYYY-MM-DD dates and ends_on cannot be before starts_on")
+	ErrAbsenceOverlap       = errors.New("absence overlaps an existing absence for this user")
+)
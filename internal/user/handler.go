@@ -2,10 +2,13 @@ package user
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"keep-your-house-clean/internal/domain"
 	"keep-your-house-clean/internal/platform/middleware"
 )
 
@@ -21,10 +24,19 @@ func (h *Handler) RegisterRoutes(r chi.Router) {
 	r.Route("/api/v1/users", func(r chi.Router) {
 		r.Get("/", h.ListUsers)
 		r.Get("/ranking", h.GetTopUsers)
+		r.Get("/leaderboard", h.GetLeaderboard)
 		r.Get("/{id}", h.GetUser)
-		r.Post("/", h.CreateUser)
+		r.Get("/{id}/points", h.GetPointsStatement)
+		r.Get("/{id}/streak", h.GetStreak)
+		r.Get("/{id}/availability", h.GetAvailability)
+		r.Put("/{id}/vacation", h.SetVacation)
+		r.Get("/{id}/absences", h.ListAbsences)
+		r.Post("/{id}/absences", h.CreateAbsence)
+		r.Put("/{id}/absences/{absenceId}", h.UpdateAbsence)
+		r.Delete("/{id}/absences/{absenceId}", h.DeleteAbsence)
+		r.With(middleware.RequirePermission(middleware.PermissionManageUsers)).Post("/", h.CreateUser)
 		r.Put("/{id}", h.UpdateUser)
-		r.Delete("/{id}", h.DeleteUser)
+		r.With(middleware.RequirePermission(middleware.PermissionManageUsers)).Delete("/{id}", h.DeleteUser)
 	})
 }
 
@@ -37,7 +49,19 @@ func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.service.CreateUser(r.Context(), req)
 	if err != nil {
-		if err.Error() == "email already exists for this tenant" {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if middleware.IsForbidden(err) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidRole) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, ErrEmailExists) {
 			respondWithError(w, http.StatusConflict, err.Error())
 			return
 		}
@@ -58,7 +82,11 @@ func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.service.GetUserByID(r.Context(), id)
 	if err != nil {
-		if err.Error() == "user not found" {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if errors.Is(err, ErrUserNotFound) {
 			respondWithError(w, http.StatusNotFound, err.Error())
 			return
 		}
@@ -69,27 +97,56 @@ func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusOK, user)
 }
 
-func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
-	tenantIDStr := r.URL.Query().Get("tenant_id")
-	var tenantID int64
-	var err error
+func (h *Handler) GetPointsStatement(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
 
-	if tenantIDStr != "" {
-		tenantID, err = strconv.ParseInt(tenantIDStr, 10, 64)
-		if err != nil {
-			respondWithError(w, http.StatusBadRequest, "Invalid tenant_id")
+	limitStr := r.URL.Query().Get("limit")
+	offsetStr := r.URL.Query().Get("offset")
+
+	limit := 50
+	offset := 0
+
+	if limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
+	}
+
+	if offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset >= 0 {
+			offset = parsedOffset
+		}
+	}
+
+	statement, err := h.service.GetPointsStatement(r.Context(), id, limit, offset)
+	if err != nil {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
 			return
 		}
-	} else {
-		tenantID = middleware.GetTenantIDFromContext(r.Context())
-		if tenantID == 0 {
-			respondWithError(w, http.StatusBadRequest, "tenant_id query parameter is required or user must be authenticated")
+		if errors.Is(err, ErrUserNotFound) {
+			respondWithError(w, http.StatusNotFound, err.Error())
 			return
 		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
 	}
 
-	users, err := h.service.ListUsers(r.Context(), tenantID)
+	respondWithJSON(w, http.StatusOK, statement)
+}
+
+func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	users, err := h.service.ListUsers(r.Context())
 	if err != nil {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -98,14 +155,19 @@ func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) GetTopUsers(w http.ResponseWriter, r *http.Request) {
-	tenantID := middleware.GetTenantIDFromContext(r.Context())
-	if tenantID == 0 {
-		respondWithError(w, http.StatusBadRequest, "user must be authenticated")
-		return
+	limit := 3
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			limit = parsedLimit
+		}
 	}
 
-	users, err := h.service.GetTopUsersByPoints(r.Context(), tenantID, 3)
+	users, err := h.service.GetTopUsersByPoints(r.Context(), limit)
 	if err != nil {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
 		respondWithError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -113,6 +175,73 @@ func (h *Handler) GetTopUsers(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusOK, users)
 }
 
+func (h *Handler) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+
+	location := time.Local
+	if tz := values.Get("tz"); tz != "" {
+		loaded, err := time.LoadLocation(tz)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid timezone")
+			return
+		}
+		location = loaded
+	}
+
+	query := LeaderboardQuery{
+		Period: domain.LeaderboardPeriod(values.Get("period")),
+		Limit:  10,
+		Now:    time.Now().In(location),
+	}
+
+	if limitStr := values.Get("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			query.Limit = parsedLimit
+		}
+	}
+
+	if fromStr := values.Get("from"); fromStr != "" {
+		from, _, err := domain.ParseLeaderboardTime(fromStr, location)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid from date")
+			return
+		}
+		query.From = &from
+	}
+
+	if toStr := values.Get("to"); toStr != "" {
+		to, dateOnly, err := domain.ParseLeaderboardTime(toStr, location)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "Invalid to date")
+			return
+		}
+		if dateOnly {
+			to = to.AddDate(0, 0, 1)
+		}
+		query.To = &to
+	}
+
+	if query.Period == "" && (query.From != nil || query.To != nil) {
+		query.Period = domain.LeaderboardCustom
+	}
+
+	leaderboard, err := h.service.GetLeaderboard(r.Context(), query)
+	if err != nil {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidLeaderboard) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, leaderboard)
+}
+
 func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	idStr := chi.URLParam(r, "id")
 	id, err := strconv.ParseInt(idStr, 10, 64)
@@ -129,11 +258,27 @@ func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.service.UpdateUser(r.Context(), id, req)
 	if err != nil {
-		if err.Error() == "user not found" {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if middleware.IsForbidden(err) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, ErrUseChangePassword) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, ErrInvalidRole) {
+			respondWithError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, ErrUserNotFound) {
 			respondWithError(w, http.StatusNotFound, err.Error())
 			return
 		}
-		if err.Error() == "email already exists for this tenant" {
+		if errors.Is(err, ErrEmailExists) {
 			respondWithError(w, http.StatusConflict, err.Error())
 			return
 		}
@@ -153,7 +298,15 @@ func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteUser(r.Context(), id); err != nil {
-		if err.Error() == "user not found" {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if middleware.IsForbidden(err) {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		if errors.Is(err, ErrUserNotFound) {
 			respondWithError(w, http.StatusNotFound, err.Error())
 			return
 		}
@@ -164,6 +317,186 @@ func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+func (h *Handler) GetStreak(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	streak, err := h.service.GetStreak(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, ErrUserNotAuthenticated) {
+			respondWithError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+		if errors.Is(err, ErrUserNotFound) {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, streak)
+}
+
+func (h *Handler) GetAvailability(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	availability, err := h.service.GetAvailability(r.Context(), id)
+	if err != nil {
+		respondWithAbsenceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, availability)
+}
+
+func (h *Handler) SetVacation(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req VacationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	availability, err := h.service.SetVacation(r.Context(), id, req)
+	if err != nil {
+		respondWithAbsenceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, availability)
+}
+
+func (h *Handler) ListAbsences(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	absences, err := h.service.ListAbsences(r.Context(), id)
+	if err != nil {
+		respondWithAbsenceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, absences)
+}
+
+func (h *Handler) CreateAbsence(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req AbsenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	absence, err := h.service.CreateAbsence(r.Context(), id, req)
+	if err != nil {
+		respondWithAbsenceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, absence)
+}
+
+func (h *Handler) UpdateAbsence(w http.ResponseWriter, r *http.Request) {
+	id, absenceID, ok := parseAbsenceParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req AbsenceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	absence, err := h.service.UpdateAbsence(r.Context(), id, absenceID, req)
+	if err != nil {
+		respondWithAbsenceError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, absence)
+}
+
+func (h *Handler) DeleteAbsence(w http.ResponseWriter, r *http.Request) {
+	id, absenceID, ok := parseAbsenceParams(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteAbsence(r.Context(), id, absenceID); err != nil {
+		respondWithAbsenceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseAbsenceParams(w http.ResponseWriter, r *http.Request) (int64, int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid user ID")
+		return 0, 0, false
+	}
+
+	absenceID, err := strconv.ParseInt(chi.URLParam(r, "absenceId"), 10, 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "Invalid absence ID")
+		return 0, 0, false
+	}
+
+	return id, absenceID, true
+}
+
+func respondWithAbsenceError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrUserNotAuthenticated) {
+		respondWithError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+	if middleware.IsForbidden(err) {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if errors.Is(err, ErrUserNotFound) || errors.Is(err, ErrAbsenceNotFound) {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	if errors.Is(err, ErrInvalidAbsence) {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if errors.Is(err, ErrAbsenceOverlap) {
+		respondWithError(w, http.StatusConflict, err.Error())
+		return
+	}
+	respondWithError(w, http.StatusInternalServerError, err.Error())
+}
+
 func respondWithJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"time"
+)
+
+func (s *Service) GetLeaderboard(ctx context.Context, query LeaderboardQuery) (*LeaderboardResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	period := query.Period
+	if period == "" {
+		period = domain.LeaderboardWeek
+	}
+	if !period.IsValid() {
+		return nil, ErrInvalidLeaderboard
+	}
+
+	now := query.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	current, previous, err := domain.ResolveLeaderboardWindows(period, now, query.From, query.To)
+	if err != nil {
+		return nil, ErrInvalidLeaderboard
+	}
+
+	users, err := s.repo.FetchAll(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRange := current.UTC()
+	currentTotals, err := s.ledgerRepo.SumByUser(ctx, tenantID, currentRange.Start, currentRange.End, domain.LeaderboardReasons)
+	if err != nil {
+		return nil, err
+	}
+
+	previousRange := previous.UTC()
+	previousTotals, err := s.ledgerRepo.SumByUser(ctx, tenantID, previousRange.Start, previousRange.End, domain.LeaderboardReasons)
+	if err != nil {
+		return nil, err
+	}
+
+	var currentScores, previousScores []domain.LeaderboardScore
+	for _, user := range users {
+		if user.Status != "active" {
+			continue
+		}
+		currentScores = append(currentScores, domain.LeaderboardScore{UserID: user.ID, Name: user.Name, Points: currentTotals[user.ID]})
+		previousScores = append(previousScores, domain.LeaderboardScore{UserID: user.ID, Name: user.Name, Points: previousTotals[user.ID]})
+	}
+
+	previousRanks := make(map[int64]int, len(previousScores))
+	for _, ranked := range domain.RankScores(previousScores) {
+		previousRanks[ranked.UserID] = ranked.Rank
+	}
+
+	entries := []LeaderboardEntry{}
+	for _, ranked := range domain.TopRanked(domain.RankScores(currentScores), query.Limit) {
+		previousPoints := previousTotals[ranked.UserID]
+		previousRank := previousRanks[ranked.UserID]
+		entries = append(entries, LeaderboardEntry{
+			Rank:           ranked.Rank,
+			UserID:         ranked.UserID,
+			Name:           ranked.Name,
+			Points:         ranked.Points,
+			PreviousRank:   previousRank,
+			PreviousPoints: previousPoints,
+			PointsDelta:    ranked.Points - previousPoints,
+			RankChange:     previousRank - ranked.Rank,
+		})
+	}
+
+	return &LeaderboardResponse{
+		Period:        period,
+		Start:         current.Start,
+		End:           current.End,
+		PreviousStart: previous.Start,
+		PreviousEnd:   previous.End,
+		Entries:       entries,
+	}, nil
+}
@@ -2,48 +2,85 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"keep-your-house-clean/internal/auth"
 	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
 	"time"
 )
 
 type Service struct {
-	repo domain.UserRepository
+	repo        domain.UserRepository
+	ledgerRepo  domain.PointsLedgerRepository
+	streakRepo  domain.StreakRepository
+	absenceRepo domain.AbsenceRepository
+	taskRepo    domain.TaskRepository
+	tokenRepo   domain.TokenRepository
+	transactor  domain.Transactor
 }
 
-func NewService(repo domain.UserRepository) *Service {
-	return &Service{repo: repo}
+func NewService(repo domain.UserRepository, ledgerRepo domain.PointsLedgerRepository, streakRepo domain.StreakRepository, absenceRepo domain.AbsenceRepository, taskRepo domain.TaskRepository, tokenRepo domain.TokenRepository, transactor domain.Transactor) *Service {
+	return &Service{
+		repo:        repo,
+		ledgerRepo:  ledgerRepo,
+		streakRepo:  streakRepo,
+		absenceRepo: absenceRepo,
+		taskRepo:    taskRepo,
+		tokenRepo:   tokenRepo,
+		transactor:  transactor,
+	}
 }
 
 func (s *Service) CreateUser(ctx context.Context, req CreateUserRequest) (*domain.User, error) {
-	existingUser, err := s.repo.GetByEmailAndTenant(ctx, req.Email, req.TenantID)
+	if err := middleware.Authorize(ctx, middleware.PermissionManageUsers); err != nil {
+		return nil, err
+	}
+
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if req.Role != "" && !isValidRole(req.Role) {
+		return nil, ErrInvalidRole
+	}
+
+	email := domain.NormalizeEmail(req.Email)
+	existingUser, err := s.repo.GetByEmailAndTenant(ctx, email, tenantID)
 	if err != nil {
 		return nil, err
 	}
 	if existingUser != nil {
-		return nil, errors.New("email already exists for this tenant")
+		return nil, ErrEmailExists
 	}
 
 	hashedPassword, err := auth.HashPassword(req.Password)
 	if err != nil {
-		return nil, errors.New("failed to hash password")
+		return nil, ErrPasswordHashFailed
 	}
 
 	now := time.Now()
 	user := &domain.User{
 		Name:       req.Name,
-		Email:      req.Email,
+		Email:      email,
 		Password:   hashedPassword,
-		TenantID:   req.TenantID,
-		Points:     req.Points,
+		TenantID:   tenantID,
+		Points:     0,
 		Role:       getRoleOrDefault(req.Role),
 		Status:     getStatusOrDefault(req.Status),
 		CreatedAt:  now,
 		UpdatedAt:  now,
 	}
 
-	if err := s.repo.Create(ctx, user); err != nil {
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, user); err != nil {
+			return err
+		}
+		return s.adjustPoints(ctx, user, req.Points, now)
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -52,13 +89,18 @@ func (s *Service) CreateUser(ctx context.Context, req CreateUserRequest) (*domai
 }
 
 func (s *Service) GetUserByID(ctx context.Context, id int64) (*domain.User, error) {
-	user, err := s.repo.GetByID(ctx, id)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	user, err := s.repo.GetByID(ctx, id, tenantID)
 	if err != nil {
 		return nil, err
 	}
 
 	if user == nil {
-		return nil, errors.New("user not found")
+		return nil, ErrUserNotFound
 	}
 
 	user.Password = ""
@@ -66,32 +108,29 @@ func (s *Service) GetUserByID(ctx context.Context, id int64) (*domain.User, erro
 }
 
 func (s *Service) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
-	user, err := s.repo.GetByEmail(ctx, email)
-	if err != nil {
-		return nil, err
-	}
-
-	if user == nil {
-		return nil, errors.New("user not found")
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
 	}
 
-	return user, nil
-}
-
-func (s *Service) GetUserByEmailAndTenant(ctx context.Context, email string, tenantID int64) (*domain.User, error) {
 	user, err := s.repo.GetByEmailAndTenant(ctx, email, tenantID)
 	if err != nil {
 		return nil, err
 	}
 
 	if user == nil {
-		return nil, errors.New("user not found")
+		return nil, ErrUserNotFound
 	}
 
 	return user, nil
 }
 
-func (s *Service) ListUsers(ctx context.Context, tenantID int64) ([]domain.User, error) {
+func (s *Service) ListUsers(ctx context.Context) ([]domain.User, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
 	users, err := s.repo.FetchAll(ctx, tenantID)
 	if err != nil {
 		return nil, err
@@ -104,7 +143,12 @@ func (s *Service) ListUsers(ctx context.Context, tenantID int64) ([]domain.User,
 	return users, nil
 }
 
-func (s *Service) GetTopUsersByPoints(ctx context.Context, tenantID int64, limit int) ([]domain.User, error) {
+func (s *Service) GetTopUsersByPoints(ctx context.Context, limit int) ([]domain.User, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
 	users, err := s.repo.GetTopUsersByPoints(ctx, tenantID, limit)
 	if err != nil {
 		return nil, err
@@ -118,39 +162,60 @@ func (s *Service) GetTopUsersByPoints(ctx context.Context, tenantID int64, limit
 }
 
 func (s *Service) UpdateUser(ctx context.Context, id int64, req UpdateUserRequest) (*domain.User, error) {
-	user, err := s.repo.GetByID(ctx, id)
+	callerID := middleware.GetUserIDFromContext(ctx)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if callerID == 0 || tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	if id != callerID || req.Points != nil || req.Role != nil || req.Status != nil {
+		if err := middleware.Authorize(ctx, middleware.PermissionManageUsers); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Password != nil && id == callerID {
+		return nil, ErrUseChangePassword
+	}
+
+	if req.Role != nil && !isValidRole(*req.Role) {
+		return nil, ErrInvalidRole
+	}
+
+	user, err := s.repo.GetByID(ctx, id, tenantID)
 	if err != nil {
 		return nil, err
 	}
 
 	if user == nil {
-		return nil, errors.New("user not found")
+		return nil, ErrUserNotFound
 	}
 
 	if req.Name != nil {
 		user.Name = *req.Name
 	}
 	if req.Email != nil {
-		existingUser, err := s.repo.GetByEmailAndTenant(ctx, *req.Email, user.TenantID)
+		email := domain.NormalizeEmail(*req.Email)
+		existingUser, err := s.repo.GetByEmailAndTenant(ctx, email, user.TenantID)
 		if err != nil {
 			return nil, err
 		}
 		if existingUser != nil && existingUser.ID != id {
-			return nil, errors.New("email already exists for this tenant")
+			return nil, ErrEmailExists
 		}
-		user.Email = *req.Email
+		user.Email = email
 	}
+	revokeTokens := false
 	if req.Password != nil {
 		hashedPassword, err := auth.HashPassword(*req.Password)
 		if err != nil {
-			return nil, errors.New("failed to hash password")
+			return nil, ErrPasswordHashFailed
 		}
 		user.Password = hashedPassword
-	}
-	if req.Points != nil {
-		user.Points = *req.Points
+		revokeTokens = true
 	}
 	if req.Role != nil {
+		revokeTokens = revokeTokens || user.Role != *req.Role
 		user.Role = *req.Role
 	}
 	if req.Status != nil {
@@ -160,9 +225,35 @@ func (s *Service) UpdateUser(ctx context.Context, id int64, req UpdateUserReques
 		user.LastLoginAt = req.LastLoginAt
 	}
 
-	user.UpdatedAt = time.Now()
+	now := time.Now()
+	user.UpdatedAt = now
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		locked, err := s.repo.GetByIDForUpdate(ctx, id, tenantID)
+		if err != nil {
+			return err
+		}
+		if locked == nil {
+			return ErrUserNotFound
+		}
+		user.Points = locked.Points
+
+		if err := s.repo.Update(ctx, user); err != nil {
+			return err
+		}
 
-	if err := s.repo.Update(ctx, user); err != nil {
+		if revokeTokens {
+			if err := s.tokenRepo.RevokeAllUserTokens(ctx, user.ID); err != nil {
+				return err
+			}
+		}
+
+		if req.Points != nil {
+			return s.adjustPoints(ctx, user, *req.Points, now)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -171,16 +262,97 @@ func (s *Service) UpdateUser(ctx context.Context, id int64, req UpdateUserReques
 }
 
 func (s *Service) DeleteUser(ctx context.Context, id int64) error {
-	return s.repo.Delete(ctx, id)
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return ErrUserNotAuthenticated
+	}
+
+	if err := middleware.Authorize(ctx, middleware.PermissionManageUsers); err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, id, tenantID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetPointsStatement(ctx context.Context, id int64, limit int, offset int) (*PointsStatementResponse, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	user, err := s.repo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	balance, err := s.ledgerRepo.GetBalance(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.ledgerRepo.FetchByUser(ctx, id, tenantID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if entries == nil {
+		entries = []domain.PointsEntry{}
+	}
+
+	return &PointsStatementResponse{
+		UserID:  id,
+		Balance: balance,
+		Entries: entries,
+	}, nil
+}
+
+func (s *Service) adjustPoints(ctx context.Context, user *domain.User, target int, now time.Time) error {
+	delta := target - user.Points
+	if delta == 0 {
+		return nil
+	}
+
+	callerID := middleware.GetUserIDFromContext(ctx)
+	_, err := s.ledgerRepo.Post(ctx, &domain.PointsEntry{
+		TenantID:       user.TenantID,
+		UserID:         user.ID,
+		Delta:          delta,
+		Reason:         domain.PointsReasonAdjustment,
+		SourceType:     domain.PointsSourceUser,
+		SourceID:       callerID,
+		IdempotencyKey: fmt.Sprintf("adjustment:%d:%d:%d", user.ID, callerID, now.UnixNano()),
+		CreatedAt:      now,
+	})
+	if err != nil {
+		return err
+	}
+
+	user.Points = target
+	return nil
 }
 
 func getRoleOrDefault(role string) string {
 	if role == "" {
-		return "user"
+		return domain.RoleUser
 	}
 	return role
 }
 
+func isValidRole(role string) bool {
+	return role == domain.RoleAdmin || role == domain.RoleUser
+}
+
 func getStatusOrDefault(status string) string {
 	if status == "" {
 		return "active"
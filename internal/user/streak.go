@@ -0,0 +1,36 @@
+package user
+
+import (
+	"context"
+	"keep-your-house-clean/internal/domain"
+	"keep-your-house-clean/internal/platform/middleware"
+	"time"
+)
+
+func (s *Service) GetStreak(ctx context.Context, id int64) (*domain.Streak, error) {
+	tenantID := middleware.GetTenantIDFromContext(ctx)
+	if tenantID == 0 {
+		return nil, ErrUserNotAuthenticated
+	}
+
+	user, err := s.repo.GetByID(ctx, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	streak, err := s.streakRepo.Get(ctx, domain.StreakUserDaily, id, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if streak == nil {
+		streak = domain.NewStreak(tenantID, domain.StreakUserDaily, id)
+	}
+
+	streak.Current = streak.CurrentAt(time.Now())
+	return streak, nil
+}
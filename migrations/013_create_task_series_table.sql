@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS task_series (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE RESTRICT,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL,
+    points INTEGER NOT NULL,
+    frequency_value INTEGER NOT NULL,
+    frequency_unit VARCHAR(20) NOT NULL CHECK (frequency_unit IN ('days', 'weeks', 'months')),
+    paused_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by_id BIGINT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_by_id BIGINT,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_series_tenant_id ON task_series(tenant_id);
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS series_id BIGINT REFERENCES task_series(id) ON DELETE SET NULL;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS previous_task_id BIGINT REFERENCES tasks(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_series_id ON tasks(series_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_previous_task_id ON tasks(previous_task_id);
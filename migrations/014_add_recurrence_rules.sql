@@ -0,0 +1,12 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS recurrence_rule TEXT;
+
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS tasks_frequency_unit_check;
+
+ALTER TABLE tasks ADD CONSTRAINT tasks_frequency_unit_check CHECK (frequency_unit IN ('', 'days', 'weeks', 'months'));
+
+ALTER TABLE task_series ADD COLUMN IF NOT EXISTS recurrence_rule TEXT;
+ALTER TABLE task_series ADD COLUMN IF NOT EXISTS starts_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+ALTER TABLE task_series DROP CONSTRAINT IF EXISTS task_series_frequency_unit_check;
+
+ALTER TABLE task_series ADD CONSTRAINT task_series_frequency_unit_check CHECK (frequency_unit IN ('', 'days', 'weeks', 'months'));
@@ -0,0 +1,3 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS anchor_mode VARCHAR(20) NOT NULL DEFAULT 'completion' CHECK (anchor_mode IN ('completion', 'schedule'));
+
+ALTER TABLE task_series ADD COLUMN IF NOT EXISTS anchor_mode VARCHAR(20) NOT NULL DEFAULT 'completion' CHECK (anchor_mode IN ('completion', 'schedule'));
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE RESTRICT,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    task_id BIGINT REFERENCES tasks(id) ON DELETE SET NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_tenant_user ON notifications(tenant_id, user_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS reminders (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE RESTRICT,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    task_id BIGINT REFERENCES tasks(id) ON DELETE CASCADE,
+    series_id BIGINT REFERENCES task_series(id) ON DELETE CASCADE,
+    trigger_type VARCHAR(20) NOT NULL CHECK (trigger_type IN ('before_due', 'overdue')),
+    offset_minutes INTEGER NOT NULL DEFAULT 0 CHECK (offset_minutes >= 0),
+    repeat_minutes INTEGER NOT NULL DEFAULT 0 CHECK (repeat_minutes >= 0),
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('in_app', 'email', 'webhook')),
+    webhook_url TEXT,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminders_tenant_user ON reminders(tenant_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_reminders_enabled ON reminders(enabled) WHERE enabled = TRUE;
+
+CREATE TABLE IF NOT EXISTS reminder_deliveries (
+    reminder_id BIGINT NOT NULL REFERENCES reminders(id) ON DELETE CASCADE,
+    task_id BIGINT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    slot TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (reminder_id, task_id, slot)
+);
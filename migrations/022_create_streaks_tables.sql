@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS streaks (
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    kind VARCHAR(30) NOT NULL CHECK (kind IN ('user_daily', 'series_on_time')),
+    subject_id BIGINT NOT NULL,
+    current INTEGER NOT NULL DEFAULT 0,
+    longest INTEGER NOT NULL DEFAULT 0,
+    last_on DATE,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (kind, subject_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_streaks_tenant ON streaks(tenant_id);
+
+CREATE TABLE IF NOT EXISTS streak_activity_days (
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    completions INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, day)
+);
+
+CREATE TABLE IF NOT EXISTS streak_occurrences (
+    task_id BIGINT PRIMARY KEY REFERENCES tasks(id) ON DELETE CASCADE,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    series_id BIGINT NOT NULL REFERENCES task_series(id) ON DELETE CASCADE,
+    scheduled_to TIMESTAMP,
+    completed_at TIMESTAMP NOT NULL,
+    on_time BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_streak_occurrences_series ON streak_occurrences(series_id, scheduled_to);
+
+CREATE TABLE IF NOT EXISTS streak_processed_events (
+    event_id BIGINT PRIMARY KEY,
+    processed_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO streak_activity_days (tenant_id, user_id, day, completions)
+SELECT t.tenant_id, t.completed_by_id, t.updated_at::date, COUNT(*)
+FROM tasks t
+WHERE t.completed = true AND t.completed_by_id IS NOT NULL AND t.deleted_at IS NULL
+GROUP BY t.tenant_id, t.completed_by_id, t.updated_at::date
+ON CONFLICT (user_id, day) DO NOTHING;
+
+INSERT INTO streak_occurrences (task_id, tenant_id, series_id, scheduled_to, completed_at, on_time)
+SELECT t.id, t.tenant_id, t.series_id, t.scheduled_to, t.updated_at,
+       t.overdue_at IS NULL AND (t.scheduled_to IS NULL OR t.updated_at <= t.scheduled_to)
+FROM tasks t
+WHERE t.completed = true AND t.series_id IS NOT NULL AND t.deleted_at IS NULL
+ON CONFLICT (task_id) DO NOTHING;
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS user_absences (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id BIGINT NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    starts_on DATE NOT NULL,
+    ends_on DATE,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_by_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (ends_on IS NULL OR ends_on >= starts_on)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_absences_tenant_dates ON user_absences(tenant_id, starts_on, ends_on);
+CREATE INDEX IF NOT EXISTS idx_user_absences_user ON user_absences(user_id, starts_on);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_absences_open_ended ON user_absences(user_id) WHERE ends_on IS NULL;
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS outbox_handler_deliveries (
+    event_id BIGINT NOT NULL REFERENCES outbox_events(id) ON DELETE CASCADE,
+    handler VARCHAR(100) NOT NULL,
+    delivered_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (event_id, handler)
+);
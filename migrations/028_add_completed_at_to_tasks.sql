@@ -0,0 +1,5 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP;
+
+UPDATE tasks SET completed_at = updated_at WHERE completed = true AND completed_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_tenant_completed_at ON tasks(tenant_id, completed_at) WHERE completed = true AND deleted_at IS NULL;